@@ -0,0 +1,118 @@
+package money
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/shopspring/decimal"
+)
+
+// Accumulator sums Money of a single currency from many goroutines at once.
+// Additions are spread over one shard per processor, each with its own lock. A goroutine mostly keeps adding
+// to the shard last used on its processor, so concurrent callers rarely contend, and the shards are only merged
+// when the sum is read. Amounts in whole minor units that fit an int64 are summed without allocating; the sum
+// itself is exact and never overflows.
+type Accumulator struct {
+	currency *Currency
+	shards   []shard
+	// hints caches shard indices per processor, as sync.Pool keeps a private cache per P.
+	hints sync.Pool
+	next  uint32
+}
+
+// shard is a partial sum of an Accumulator, padded to its own cache line.
+type shard struct {
+	mu    sync.Mutex
+	small int64
+	large decimal.Decimal
+	count int64
+	_     [64]byte
+}
+
+// NewAccumulator creates an empty Accumulator of Money in the currency with the given code.
+func NewAccumulator(code string) *Accumulator {
+	return &Accumulator{
+		currency: newCurrency(code).get(),
+		shards:   make([]shard, runtime.GOMAXPROCS(0)),
+	}
+}
+
+// Add adds the given Money to the sum. It is safe for concurrent use. All Money must be in the
+// currency of the Accumulator, otherwise ErrCurrencyMismatch is returned and none of it is added.
+func (a *Accumulator) Add(ms ...*Money) error {
+	for _, m := range ms {
+		if !m.currency.equals(a.currency) {
+			return ErrCurrencyMismatch
+		}
+	}
+
+	i := a.shard()
+	defer a.hints.Put(i)
+
+	s := &a.shards[*i]
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, m := range ms {
+		s.add(m.amount)
+	}
+
+	return nil
+}
+
+// shard returns the index of the shard to add to, preferably the one last used on the current processor.
+// A new index is only handed out, round-robin, when the processor has none cached.
+func (a *Accumulator) shard() *uint32 {
+	if i, ok := a.hints.Get().(*uint32); ok {
+		return i
+	}
+
+	i := atomic.AddUint32(&a.next, 1) % uint32(len(a.shards))
+	return &i
+}
+
+// Sum returns new Money struct with value representing the sum of all Money added so far.
+func (a *Accumulator) Sum() *Money {
+	sum := decimal.Zero
+	for i := range a.shards {
+		s := &a.shards[i]
+		s.mu.Lock()
+		sum = mutate.calc.add(sum, mutate.calc.add(s.large, decimal.NewFromInt(s.small)))
+		s.mu.Unlock()
+	}
+
+	return &Money{amount: sum, currency: a.currency}
+}
+
+// Count returns how many Money were added so far.
+func (a *Accumulator) Count() int64 {
+	var n int64
+	for i := range a.shards {
+		s := &a.shards[i]
+		s.mu.Lock()
+		n += s.count
+		s.mu.Unlock()
+	}
+
+	return n
+}
+
+// add adds an amount to the shard, which must be locked.
+func (s *shard) add(amount Amount) {
+	s.count++
+
+	// Fast path for whole minor units, spilling into the decimal sum before the int64 one overflows.
+	if amount.Exponent() == 0 && amount.NumDigits() <= 18 {
+		v := amount.CoefficientInt64()
+		if (v > 0 && s.small > math.MaxInt64-v) || (v < 0 && s.small < math.MinInt64-v) {
+			s.large = mutate.calc.add(s.large, decimal.NewFromInt(s.small))
+			s.small = 0
+		}
+		s.small += v
+		return
+	}
+
+	s.large = mutate.calc.add(s.large, amount)
+}
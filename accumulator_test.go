@@ -0,0 +1,111 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAccumulator(t *testing.T) {
+	a := NewAccumulator(EUR)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+
+			for i := int64(1); i <= 1000; i++ {
+				if err := a.Add(New(i, EUR)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if sum := a.Sum(); sum.Amount() != 8*500500 || sum.Currency().Code != EUR {
+		t.Errorf("Expected %d %s got %d %s", 8*500500, EUR, sum.Amount(), sum.Currency().Code)
+	}
+
+	if n := a.Count(); n != 8000 {
+		t.Errorf("Expected count %d got %d", 8000, n)
+	}
+}
+
+func TestAccumulator_Overflow(t *testing.T) {
+	a := NewAccumulator(USD)
+	for i := 0; i < 4; i++ {
+		if err := a.Add(New(math.MaxInt64, USD), New(1, USD)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := decimal.NewFromInt(math.MaxInt64).Add(decimal.NewFromInt(1)).Mul(decimal.NewFromInt(4))
+	if sum := a.Sum(); !sum.amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, sum.amount)
+	}
+
+	a = NewAccumulator(USD)
+	for i := 0; i < 4; i++ {
+		if err := a.Add(New(math.MinInt64, USD), New(-1, USD)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected = decimal.NewFromInt(math.MinInt64).Sub(decimal.NewFromInt(1)).Mul(decimal.NewFromInt(4))
+	if sum := a.Sum(); !sum.amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, sum.amount)
+	}
+}
+
+func TestAccumulator_Decimal(t *testing.T) {
+	a := NewAccumulator(USD)
+	if err := a.Add(NewFromDecimal(decimal.RequireFromString("0.005"), USD), New(1, USD), NewFromDecimal(decimal.RequireFromString("0.005"), USD)); err != nil {
+		t.Fatal(err)
+	}
+
+	if sum := a.Sum(); !sum.amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("Expected %d got %s", 2, sum.amount)
+	}
+}
+
+func TestAccumulator_CurrencyMismatch(t *testing.T) {
+	a := NewAccumulator(EUR)
+	if err := a.Add(New(100, EUR), New(100, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if sum := a.Sum(); !sum.IsZero() || a.Count() != 0 {
+		t.Errorf("Expected nothing to be added, got %d in %d additions", sum.Amount(), a.Count())
+	}
+}
+
+func BenchmarkAccumulator_Add(b *testing.B) {
+	a := NewAccumulator(EUR)
+	m := New(12345, EUR)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = a.Add(m)
+		}
+	})
+}
+
+// BenchmarkAccumulator_Mutex is the baseline of BenchmarkAccumulator_Add: the same sum behind a single lock.
+func BenchmarkAccumulator_Mutex(b *testing.B) {
+	var s shard
+	m := New(12345, EUR)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.mu.Lock()
+			s.add(m.amount)
+			s.mu.Unlock()
+		}
+	})
+}
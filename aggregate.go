@@ -0,0 +1,182 @@
+package money
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoMoney happens when aggregating an empty list of Money, whose currency is unknown.
+var ErrNoMoney = newError("money.no_money", "at least one Money is required")
+
+// Sum returns new Money struct with value representing the sum of all given Money.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned; an empty list returns ErrNoMoney.
+func Sum(ms ...*Money) (*Money, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoMoney
+	}
+
+	return ms[0].Add(ms[1:]...)
+}
+
+// Min returns the smallest of the given Money, the first one when several are equal.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned; an empty list returns ErrNoMoney.
+func Min(ms ...*Money) (*Money, error) {
+	return pick(ms, -1)
+}
+
+// Max returns the largest of the given Money, the first one when several are equal.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned; an empty list returns ErrNoMoney.
+func Max(ms ...*Money) (*Money, error) {
+	return pick(ms, 1)
+}
+
+// Average returns new Money struct with value representing the mean of the given Money,
+// rounded to whole minor units using the given rounding mode.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned; an empty list returns ErrNoMoney.
+func Average(mode RoundingMode, ms ...*Money) (*Money, error) {
+	sum, err := Sum(ms...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sum.DivideDecimal(decimal.NewFromInt(int64(len(ms))), mode)
+}
+
+// CommonIncrement returns the largest amount all the given Money are whole multiples of, their greatest common
+// divisor, e.g. €0.05 for €1.05, €2.50 and €0.15. Use it to check that a pricing grid only uses the increments a
+// vending machine or kiosk accepts. Signs are ignored, and it is zero when all Money are.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned; an empty list returns ErrNoMoney.
+func CommonIncrement(ms ...*Money) (*Money, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoMoney
+	}
+
+	// align all amounts to whole units of the smallest exponent, so that precision beyond the currency counts too
+	var shift int32
+	for _, m := range ms {
+		if err := ms[0].assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		if e := -m.amount.Exponent(); e > shift {
+			shift = e
+		}
+	}
+
+	g := new(big.Int)
+	for _, m := range ms {
+		g.GCD(nil, nil, g, new(big.Int).Abs(m.amount.Shift(shift).BigInt()))
+	}
+
+	return &Money{amount: decimal.NewFromBigInt(g, -shift), currency: ms[0].currency}, nil
+}
+
+// TopK returns the k largest of the given Money, largest first, such as the ten biggest invoices of a month.
+// Equal Money keep their order in values. Fewer than k values are all returned, sorted.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned.
+func TopK(values []*Money, k int) ([]*Money, error) {
+	return selectK(values, k, 1)
+}
+
+// BottomK returns the k smallest of the given Money, smallest first.
+// Equal Money keep their order in values. Fewer than k values are all returned, sorted.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned.
+func BottomK(values []*Money, k int) ([]*Money, error) {
+	return selectK(values, k, -1)
+}
+
+// selectK returns the k first Money in the given direction, -1 for the smallest and 1 for the largest.
+// It keeps the selection in a heap rooted at its worst Money, so it takes O(n log k) rather than sorting all values.
+func selectK(ms []*Money, k int, dir int) ([]*Money, error) {
+	for _, m := range ms {
+		if err := ms[0].assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+	}
+
+	if k > len(ms) {
+		k = len(ms)
+	}
+
+	if k <= 0 {
+		return []*Money{}, nil
+	}
+
+	h := &rankHeap{items: make([]ranked, 0, k), dir: dir}
+	for i, m := range ms {
+		r := ranked{money: m, index: i}
+		if len(h.items) < k {
+			heap.Push(h, r)
+			continue
+		}
+
+		if h.before(r, h.items[0]) {
+			h.items[0] = r
+			heap.Fix(h, 0)
+		}
+	}
+
+	out := make([]*Money, len(h.items))
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(h).(ranked).money
+	}
+
+	return out, nil
+}
+
+// ranked is Money with its position in the input, to break ties in favour of the earlier one.
+type ranked struct {
+	money *Money
+	index int
+}
+
+// rankHeap implements heap.Interface with the Money that selectK would keep last at its root.
+type rankHeap struct {
+	items []ranked
+	dir   int
+}
+
+// before reports whether a is selected ahead of b.
+func (h *rankHeap) before(a, b ranked) bool {
+	if c := a.money.compare(b.money); c != 0 {
+		return c == h.dir
+	}
+
+	return a.index < b.index
+}
+
+func (h *rankHeap) Len() int           { return len(h.items) }
+func (h *rankHeap) Less(i, j int) bool { return h.before(h.items[j], h.items[i]) }
+func (h *rankHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *rankHeap) Push(x interface{}) {
+	h.items = append(h.items, x.(ranked))
+}
+
+func (h *rankHeap) Pop() interface{} {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+// pick returns the first Money comparing to all others in the given direction, -1 for the smallest and 1 for the largest.
+func pick(ms []*Money, dir int) (*Money, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoMoney
+	}
+
+	best := ms[0]
+	for _, m := range ms[1:] {
+		if err := best.assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		if m.compare(best) == dir {
+			best = m
+		}
+	}
+
+	return best, nil
+}
@@ -0,0 +1,212 @@
+package money
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestSum(t *testing.T) {
+	m, err := Sum(New(100, EUR), New(250, EUR), New(-50, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != 300 || m.Currency().Code != EUR {
+		t.Errorf("Expected %d %s got %d %s", 300, EUR, m.Amount(), m.Currency().Code)
+	}
+
+	if m, err = Sum(New(100, EUR)); err != nil || m.Amount() != 100 {
+		t.Errorf("Expected %d got %v %v", 100, m, err)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	ms := []*Money{New(100, EUR), New(-5, EUR), New(300, EUR), New(-5, EUR)}
+
+	min, err := Min(ms...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if min != ms[1] {
+		t.Errorf("Expected the first smallest Money %d got %d", ms[1].Amount(), min.Amount())
+	}
+
+	max, err := Max(ms...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if max != ms[2] {
+		t.Errorf("Expected %d got %d", ms[2].Amount(), max.Amount())
+	}
+}
+
+func TestAverage(t *testing.T) {
+	tcs := []struct {
+		amounts  []int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{[]int64{100, 200, 300}, RoundHalfUp, 200},
+		{[]int64{100, 101}, RoundHalfUp, 101},
+		{[]int64{100, 101}, RoundHalfEven, 100},
+		{[]int64{100, 100, 101}, RoundCeiling, 101},
+		{[]int64{-100, -101}, RoundFloor, -101},
+	}
+
+	for _, tc := range tcs {
+		ms := make([]*Money, len(tc.amounts))
+		for i, a := range tc.amounts {
+			ms[i] = New(a, EUR)
+		}
+
+		m, err := Average(tc.mode, ms...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.Amount() != tc.expected {
+			t.Errorf("Expected average of %v with %s to be %d got %d", tc.amounts, tc.mode, tc.expected, m.Amount())
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	ms := []*Money{New(100, EUR), New(500, EUR), New(300, EUR), New(500, EUR), New(-50, EUR)}
+
+	top, err := TopK(ms, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*Money{ms[1], ms[3], ms[2]}
+	for i := range expected {
+		if top[i] != expected[i] {
+			t.Errorf("Expected TopK[%d] to be %d got %d", i, expected[i].Amount(), top[i].Amount())
+		}
+	}
+
+	bottom, err := BottomK(ms, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bottom) != 2 || bottom[0] != ms[4] || bottom[1] != ms[0] {
+		t.Errorf("Expected BottomK to be [-50 100] got %v", bottom)
+	}
+
+	if all, _ := TopK(ms, 10); len(all) != len(ms) || all[0] != ms[1] || all[4] != ms[4] {
+		t.Errorf("Expected all Money sorted got %v", all)
+	}
+
+	if none, err := TopK(ms, 0); err != nil || len(none) != 0 {
+		t.Errorf("Expected no Money got %v %v", none, err)
+	}
+
+	if none, err := BottomK(nil, 3); err != nil || len(none) != 0 {
+		t.Errorf("Expected no Money got %v %v", none, err)
+	}
+}
+
+func TestTopK_MatchesSort(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	ms := make([]*Money, 1000)
+	for i := range ms {
+		ms[i] = New(rnd.Int63n(200)-100, EUR)
+	}
+
+	sorted := append([]*Money(nil), ms...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].compare(sorted[j]) > 0 })
+
+	for _, k := range []int{1, 7, 100, 1000} {
+		top, err := TopK(ms, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range top {
+			if top[i] != sorted[i] {
+				t.Fatalf("Expected TopK(%d)[%d] to be %d got %d", k, i, sorted[i].Amount(), top[i].Amount())
+			}
+		}
+	}
+}
+
+func TestAggregate_Errors(t *testing.T) {
+	if _, err := Sum(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := Min(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := Average(RoundHalfUp); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	ms := []*Money{New(100, EUR), New(100, USD)}
+	if _, err := Sum(ms...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Max(ms...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := Average(RoundHalfUp, ms...); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := TopK(ms, 1); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := BottomK(ms, 0); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestCommonIncrement(t *testing.T) {
+	tcs := []struct {
+		amounts  []string
+		expected string
+	}{
+		{[]string{"1.05", "2.50", "0.15"}, "0.05"},
+		{[]string{"2", "3"}, "1"},
+		{[]string{"-0.20", "0.30"}, "0.1"},
+		{[]string{"4.20"}, "4.2"},
+		{[]string{"0", "0.75"}, "0.75"},
+		{[]string{"0", "0"}, "0"},
+		{[]string{"0.025", "0.10"}, "0.025"},
+	}
+
+	for _, tc := range tcs {
+		ms := make([]*Money, len(tc.amounts))
+		for i, a := range tc.amounts {
+			ms[i] = NewFromDecimal(decimal.RequireFromString(a), EUR)
+		}
+
+		m, err := CommonIncrement(ms...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.Decimal().String() != tc.expected || m.Currency().Code != EUR {
+			t.Errorf("Expected common increment of %v to be %s got %s", tc.amounts, tc.expected, m.Decimal())
+		}
+	}
+
+	if _, err := CommonIncrement(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := CommonIncrement(New(5, EUR), New(5, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,106 @@
+package money
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// RemainderStrategy selects which parties receive the leftover minor units of an allocation,
+// one unit each, after every party got its share rounded towards zero.
+type RemainderStrategy int
+
+const (
+	// RemainderFirst gives the leftover to the first parties. It is how Split and Allocate distribute leftover
+	// pennies, so it is also known as RemainderRoundRobin.
+	RemainderFirst RemainderStrategy = iota
+	// RemainderLast gives the leftover to the last parties.
+	RemainderLast
+	// RemainderLargest gives the leftover to the parties whose share lost the most when rounded,
+	// the largest remainder method. Ties go to the first of them.
+	RemainderLargest
+
+	// RemainderRoundRobin is RemainderFirst.
+	RemainderRoundRobin = RemainderFirst
+)
+
+var remainderStrategyNames = map[RemainderStrategy]string{
+	RemainderFirst:   "first",
+	RemainderLast:    "last",
+	RemainderLargest: "largest",
+}
+
+// String returns the name of the remainder strategy, e.g. "largest".
+func (s RemainderStrategy) String() string {
+	if name, ok := remainderStrategyNames[s]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// AllocateDecimal returns slice of Money structs with split Self value in given decimal ratios, such as
+// 33.33 and 66.67, without losing pennies. Leftover pennies are distributed according to the strategy,
+// and only to parties with a non-zero ratio.
+// Only whole minor units are allocated; any precision beyond the currency fraction is truncated first.
+func (m *Money) AllocateDecimal(strategy RemainderStrategy, rs ...decimal.Decimal) ([]*Money, error) {
+	if len(rs) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	sum := decimal.Zero
+	for _, r := range rs {
+		if r.IsNegative() {
+			return nil, ErrNegativeRatio
+		}
+		sum = sum.Add(r)
+	}
+
+	t := m.amount.Truncate(0)
+	ms := make([]*Money, len(rs))
+	lost := make([]decimal.Decimal, len(rs))
+	lo := t
+	for i, r := range rs {
+		share := decimal.Zero
+		if !sum.IsZero() {
+			share, lost[i] = mutate.calc.multiplyDecimal(t, r).QuoRem(sum, 0)
+			lost[i] = lost[i].Abs()
+		}
+
+		ms[i] = &Money{amount: share, currency: m.currency}
+		lo = mutate.calc.subtract(lo, share)
+	}
+
+	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
+	// with the leftover
+	if sum.IsZero() {
+		return ms, nil
+	}
+
+	parties := make([]int, 0, len(rs))
+	for i, r := range rs {
+		if !r.IsZero() {
+			parties = append(parties, i)
+		}
+	}
+
+	switch strategy {
+	case RemainderLast:
+		for i, j := 0, len(parties)-1; i < j; i, j = i+1, j-1 {
+			parties[i], parties[j] = parties[j], parties[i]
+		}
+	case RemainderLargest:
+		sort.SliceStable(parties, func(i, j int) bool {
+			return lost[parties[i]].GreaterThan(lost[parties[j]])
+		})
+	}
+
+	unit := decimal.NewFromInt(int64(lo.Sign()))
+	for p := 0; !lo.IsZero(); p++ {
+		party := ms[parties[p%len(parties)]]
+		party.amount = mutate.calc.add(party.amount, unit)
+		lo = mutate.calc.subtract(lo, unit)
+	}
+
+	return ms, nil
+}
@@ -0,0 +1,94 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func decimals(vs ...string) []decimal.Decimal {
+	ds := make([]decimal.Decimal, len(vs))
+	for i, v := range vs {
+		ds[i] = decimal.RequireFromString(v)
+	}
+
+	return ds
+}
+
+func TestMoney_AllocateDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		strategy RemainderStrategy
+		ratios   []string
+		expected []int64
+	}{
+		{100, RemainderFirst, []string{"33.33", "66.67"}, []int64{34, 66}},
+		{100, RemainderLargest, []string{"33.33", "66.67"}, []int64{33, 67}},
+		{100, RemainderFirst, []string{"1", "1", "1"}, []int64{34, 33, 33}},
+		{100, RemainderLast, []string{"1", "1", "1"}, []int64{33, 33, 34}},
+		{101, RemainderLast, []string{"1", "1", "1"}, []int64{33, 34, 34}},
+		{100, RemainderLargest, []string{"0.2", "0.35", "0.45"}, []int64{20, 35, 45}},
+		{10, RemainderLargest, []string{"0.14", "0.36", "0.5"}, []int64{1, 4, 5}},
+		{10, RemainderFirst, []string{"0.14", "0.36", "0.5"}, []int64{2, 3, 5}},
+		{-100, RemainderFirst, []string{"1", "1", "1"}, []int64{-34, -33, -33}},
+		{-10, RemainderLargest, []string{"0.14", "0.36", "0.5"}, []int64{-1, -4, -5}},
+		{5, RemainderFirst, []string{"0", "1", "1"}, []int64{0, 3, 2}},
+		{5, RemainderLast, []string{"1", "1", "0"}, []int64{2, 3, 0}},
+		{100, RemainderFirst, []string{"0", "0"}, []int64{0, 0}},
+		{100, RemainderRoundRobin, []string{"1"}, []int64{100}},
+	}
+
+	for _, tc := range tcs {
+		ms, err := New(tc.amount, EUR).AllocateDecimal(tc.strategy, decimals(tc.ratios...)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, m := range ms {
+			if m.Amount() != tc.expected[i] {
+				t.Errorf("Expected allocation of %d by %v (%s) to be %v, party %d got %d", tc.amount, tc.ratios, tc.strategy, tc.expected, i, m.Amount())
+			}
+		}
+	}
+}
+
+func TestMoney_AllocateDecimalSum(t *testing.T) {
+	strategies := []RemainderStrategy{RemainderFirst, RemainderLast, RemainderLargest}
+	ratios := decimals("33.33", "0", "16.5", "50.17", "0.001")
+
+	for _, s := range strategies {
+		for amount := int64(-1000); amount <= 1000; amount += 37 {
+			ms, err := New(amount, EUR).AllocateDecimal(s, ratios...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sum, _ := Sum(ms...)
+			if sum.Amount() != amount || ms[1].Amount() != 0 {
+				t.Errorf("Expected allocation of %d (%s) to sum back, got %d with %d for the zero ratio", amount, s, sum.Amount(), ms[1].Amount())
+			}
+		}
+	}
+}
+
+func TestMoney_AllocateDecimalErrors(t *testing.T) {
+	m := New(100, EUR)
+
+	if _, err := m.AllocateDecimal(RemainderFirst); err == nil {
+		t.Error("Expected error allocating without ratios")
+	}
+
+	if _, err := m.AllocateDecimal(RemainderFirst, decimals("1", "-1")...); err == nil {
+		t.Error("Expected error allocating with a negative ratio")
+	}
+}
+
+func TestRemainderStrategy_String(t *testing.T) {
+	if s := RemainderLargest.String(); s != "largest" {
+		t.Errorf("Expected %s got %s", "largest", s)
+	}
+
+	if s := RemainderStrategy(42).String(); s != "unknown" {
+		t.Errorf("Expected %s got %s", "unknown", s)
+	}
+}
@@ -0,0 +1,124 @@
+package money
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// Backend performs the arithmetic behind Money on amounts in minor units.
+// Money always stores its amount as a decimal.Decimal, so the public API is the same whichever backend is in use;
+// a backend decides how results are computed, e.g. with which precision divisions are carried out.
+// Signs, comparisons and shifts are exact in any backend and are done by Money itself.
+type Backend interface {
+	// Add returns a + b.
+	Add(a, b Amount) Amount
+	// Sub returns a - b.
+	Sub(a, b Amount) Amount
+	// Mul returns a × b.
+	Mul(a, b Amount) Amount
+	// Div returns a ÷ b, rounded to the precision of the backend when it isn't exact.
+	Div(a, b Amount) Amount
+	// QuoRem returns the integer quotient q and the remainder r of a ÷ b, such that a = q × b + r,
+	// with r of the same sign as a and smaller than b in absolute value.
+	QuoRem(a, b Amount) (q, r Amount)
+}
+
+// defaultBackend is the backend Money uses unless SetBackend replaces it.
+var defaultBackend Backend = DecimalBackend{}
+
+// SetBackend replaces the backend used by all Money arithmetic, or restores the default one when b is nil.
+// It isn't safe for concurrent use with arithmetic: call it once at program start-up.
+func SetBackend(b Backend) {
+	if b == nil {
+		b = defaultBackend
+	}
+
+	mutate.calc.backend = b
+}
+
+// DecimalBackend is the default Backend, computing with shopspring/decimal. Integer amounts that fit an int64,
+// which is the case for nearly all amounts in minor units, skip the allocations of big numbers; additions and
+// subtractions also do for amounts with up to 18 significant digits, through 128-bit fixed-point arithmetic.
+// Divisions are carried out to decimal.DivisionPrecision digits.
+type DecimalBackend struct{}
+
+// Add returns a + b.
+func (DecimalBackend) Add(a, b Amount) Amount {
+	if x, y, e, ok := fixedPair(a, b); ok {
+		if r, ok := x.add(y).int64(); ok {
+			return decimal.New(r, e)
+		}
+	}
+
+	return a.Add(b)
+}
+
+// Sub returns a - b.
+func (DecimalBackend) Sub(a, b Amount) Amount {
+	if x, y, e, ok := fixedPair(a, b); ok {
+		if r, ok := x.sub(y).int64(); ok {
+			return decimal.New(r, e)
+		}
+	}
+
+	return a.Sub(b)
+}
+
+// Mul returns a × b.
+func (DecimalBackend) Mul(a, b Amount) Amount {
+	if x, y, ok := smallPair(a, b); ok {
+		if r := x * y; x == 0 || (r/x == y && !(x == -1 && y == math.MinInt64)) {
+			return decimal.NewFromInt(r)
+		}
+	}
+
+	return a.Mul(b)
+}
+
+// mulInt returns a × m without converting m to a decimal when the fast path applies.
+func (b DecimalBackend) mulInt(a Amount, m int64) Amount {
+	if x, ok := small(a); ok {
+		if r := x * m; x == 0 || (r/x == m && !(x == -1 && m == math.MinInt64)) {
+			return decimal.NewFromInt(r)
+		}
+	}
+
+	return a.Mul(decimal.NewFromInt(m))
+}
+
+// Div returns a ÷ b, carried out to decimal.DivisionPrecision digits.
+func (DecimalBackend) Div(a, b Amount) Amount {
+	return a.Div(b)
+}
+
+// QuoRem returns the integer quotient and the remainder of a ÷ b.
+func (DecimalBackend) QuoRem(a, b Amount) (Amount, Amount) {
+	if x, y, ok := smallPair(a, b); ok && y != 0 && !(x == math.MinInt64 && y == -1) {
+		return decimal.NewFromInt(x / y), decimal.NewFromInt(x % y)
+	}
+
+	return a.QuoRem(b, 0)
+}
+
+// small returns a as int64 when it is an integer that comfortably fits one, which is the case for nearly all
+// amounts in minor units, so that arithmetic on it can skip the allocations of big numbers.
+// Callers must still check their int64 results for overflow.
+func small(a Amount) (int64, bool) {
+	if a.Exponent() != 0 || a.NumDigits() > 18 {
+		return 0, false
+	}
+
+	return a.CoefficientInt64(), true
+}
+
+// smallPair returns a and b as int64 when both are small, see small.
+func smallPair(a, b Amount) (int64, int64, bool) {
+	x, ok := small(a)
+	if !ok {
+		return 0, 0, false
+	}
+
+	y, ok := small(b)
+	return x, y, ok
+}
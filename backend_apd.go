@@ -0,0 +1,167 @@
+//go:build money_apd
+// +build money_apd
+
+package money
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/shopspring/decimal"
+)
+
+// Building with the money_apd tag makes APDBackend, with decimal128 precision, the default backend.
+func init() {
+	defaultBackend = NewAPDBackend(nil)
+	mutate.calc.backend = defaultBackend
+}
+
+// ConditionError reports the exceptional conditions an APDBackend raised, such as apd.Inexact when a result
+// was rounded to the precision of its context. It matches ErrLossyConversion for inexact results and
+// ErrOverflow for overflows with errors.Is.
+type ConditionError struct {
+	Condition apd.Condition
+}
+
+func (e *ConditionError) Error() string {
+	return "decimal conditions raised: " + e.Condition.String()
+}
+
+// Is makes errors.Is match the sentinel errors for the raised conditions.
+func (e *ConditionError) Is(target error) bool {
+	switch target {
+	case ErrLossyConversion:
+		return e.Condition.Inexact()
+	case ErrOverflow:
+		return e.Condition.Overflow() || e.Condition.SystemOverflow()
+	case ErrDivisionByZero:
+		return e.Condition.DivisionByZero()
+	}
+
+	return false
+}
+
+// ErrorCode implements CodedError, returning the code of the sentinel error the conditions match,
+// or "money.decimal_condition" for other conditions.
+func (e *ConditionError) ErrorCode() string {
+	for _, err := range []error{ErrDivisionByZero, ErrOverflow, ErrLossyConversion} {
+		if e.Is(err) {
+			return ErrorCode(err)
+		}
+	}
+
+	return "money.decimal_condition"
+}
+
+// APDBackend is a Backend computing with cockroachdb/apd under an explicit apd.Context, for IEEE 754-2008
+// decimal semantics: every result is rounded to the precision and rounding of the context.
+// Rather than failing an operation, the conditions it raises are recorded, whatever the traps of the context,
+// and reported by Err until Reset. Results that aren't finite numbers, such as the quotient of a
+// division impossible under the context precision, are returned as zero with their condition recorded.
+// It is safe for concurrent use, but the conditions are shared by all its callers, including all Money arithmetic
+// once it is installed with SetBackend: use a Fork per request or batch to check only the conditions it raised.
+type APDBackend struct {
+	ctx apd.Context
+
+	mu        sync.Mutex
+	condition apd.Condition
+}
+
+// NewAPDBackend creates an APDBackend computing under ctx, or under 34 digits of precision,
+// the precision of decimal128, when ctx is nil.
+func NewAPDBackend(ctx *apd.Context) *APDBackend {
+	if ctx == nil {
+		ctx = apd.BaseContext.WithPrecision(34)
+	}
+
+	b := &APDBackend{ctx: *ctx}
+	b.ctx.Traps = 0
+	return b
+}
+
+// Fork returns a new APDBackend computing under the same context, with no conditions recorded. The conditions
+// raised by either backend are never seen by the other, so concurrent requests can each compute on their own fork
+// and tell which conditions their own operations raised.
+func (b *APDBackend) Fork() *APDBackend {
+	return &APDBackend{ctx: b.ctx}
+}
+
+// Conditions returns the conditions raised since the backend was created or last Reset.
+func (b *APDBackend) Conditions() apd.Condition {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.condition
+}
+
+// Err returns a *ConditionError for the conditions raised since the backend was created or last Reset,
+// by any of its callers, or nil if none was. Only apd.Rounded is ignored, as rounding away trailing zeros loses nothing.
+func (b *APDBackend) Err() error {
+	if c := b.Conditions() &^ apd.Rounded; c != 0 {
+		return &ConditionError{Condition: c}
+	}
+
+	return nil
+}
+
+// Reset clears the recorded conditions.
+func (b *APDBackend) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.condition = 0
+}
+
+// Add returns a + b.
+func (b *APDBackend) Add(x, y Amount) Amount {
+	return b.apply(b.ctx.Add, x, y)
+}
+
+// Sub returns a - b.
+func (b *APDBackend) Sub(x, y Amount) Amount {
+	return b.apply(b.ctx.Sub, x, y)
+}
+
+// Mul returns a × b.
+func (b *APDBackend) Mul(x, y Amount) Amount {
+	return b.apply(b.ctx.Mul, x, y)
+}
+
+// Div returns a ÷ b, rounded to the context precision.
+func (b *APDBackend) Div(x, y Amount) Amount {
+	return b.apply(b.ctx.Quo, x, y)
+}
+
+// QuoRem returns the integer quotient and the remainder of a ÷ b.
+func (b *APDBackend) QuoRem(x, y Amount) (Amount, Amount) {
+	return b.apply(b.ctx.QuoInteger, x, y), b.apply(b.ctx.Rem, x, y)
+}
+
+func (b *APDBackend) apply(op func(d, x, y *apd.Decimal) (apd.Condition, error), x, y Amount) Amount {
+	var d apd.Decimal
+	c, _ := op(&d, toAPD(x), toAPD(y))
+	if c != 0 {
+		b.mu.Lock()
+		b.condition |= c
+		b.mu.Unlock()
+	}
+
+	if d.Form != apd.Finite {
+		return decimal.Zero
+	}
+
+	return fromAPD(&d)
+}
+
+func toAPD(d Amount) *apd.Decimal {
+	return apd.NewWithBigInt(new(apd.BigInt).SetMathBigInt(d.Coefficient()), d.Exponent())
+}
+
+func fromAPD(d *apd.Decimal) Amount {
+	coeff := d.Coeff.MathBigInt()
+	if d.Negative {
+		coeff.Neg(coeff)
+	}
+
+	return decimal.NewFromBigInt(coeff, d.Exponent)
+}
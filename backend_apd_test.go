@@ -0,0 +1,108 @@
+//go:build money_apd
+// +build money_apd
+
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cockroachdb/apd/v3"
+	"github.com/shopspring/decimal"
+)
+
+func TestAPDBackend_Default(t *testing.T) {
+	if _, ok := mutate.calc.backend.(*APDBackend); !ok {
+		t.Errorf("Expected the money_apd build tag to select APDBackend got %T", mutate.calc.backend)
+	}
+}
+
+func TestAPDBackend(t *testing.T) {
+	b := NewAPDBackend(nil)
+	d := decimal.RequireFromString
+
+	tcs := []struct {
+		name     string
+		got      Amount
+		expected Amount
+	}{
+		{"add", b.Add(d("100"), d("-250")), d("-150")},
+		{"add big", b.Add(d("9223372036854775807"), d("1")), d("9223372036854775808")},
+		{"sub", b.Sub(d("0.5"), d("1")), d("-0.5")},
+		{"mul", b.Mul(d("100"), d("0.075")), d("7.5")},
+		{"div", b.Div(d("1"), d("4")), d("0.25")},
+	}
+
+	for _, tc := range tcs {
+		if !tc.got.Equal(tc.expected) {
+			t.Errorf("%s: expected %s got %s", tc.name, tc.expected, tc.got)
+		}
+	}
+
+	if q, r := b.QuoRem(d("-7"), d("2")); !q.Equal(d("-3")) || !r.Equal(d("-1")) {
+		t.Errorf("Expected -3 remainder -1 got %s remainder %s", q, r)
+	}
+
+	if err := b.Err(); err != nil {
+		t.Errorf("Expected exact results got %v", err)
+	}
+}
+
+func TestAPDBackend_Conditions(t *testing.T) {
+	b := NewAPDBackend(apd.BaseContext.WithPrecision(5))
+	SetBackend(b)
+	defer SetBackend(nil)
+
+	if _, err := New(99999, EUR).Add(New(2, EUR)); err != nil {
+		t.Fatal(err)
+	}
+
+	err := b.Err()
+	if !errors.Is(err, ErrLossyConversion) || errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected an inexact condition got %v", err)
+	}
+
+	var ce *ConditionError
+	if !errors.As(err, &ce) || !ce.Condition.Inexact() {
+		t.Errorf("Expected a *ConditionError got %T", err)
+	}
+	if code := ErrorCode(err); code != "money.lossy_conversion" {
+		t.Errorf("Expected %q got %q", "money.lossy_conversion", code)
+	}
+
+	b.Reset()
+	if err := b.Err(); err != nil || b.Conditions() != 0 {
+		t.Errorf("Expected no conditions after Reset got %v", err)
+	}
+
+	// the quotient needs more digits than the precision allows
+	if q, _ := b.QuoRem(decimal.NewFromInt(1e9), decimal.NewFromInt(3)); !q.IsZero() || !b.Conditions().DivisionImpossible() {
+		t.Errorf("Expected an impossible division got %s %s", q, b.Conditions())
+	}
+
+	b.Reset()
+	b.Div(decimal.NewFromInt(1), decimal.Zero)
+	if err := b.Err(); !errors.Is(err, ErrDivisionByZero) || ErrorCode(err) != "money.division_by_zero" {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+}
+
+func TestAPDBackend_Fork(t *testing.T) {
+	b := NewAPDBackend(apd.BaseContext.WithPrecision(5))
+	f := b.Fork()
+
+	if r := f.Add(decimal.NewFromInt(99999), decimal.NewFromInt(2)); !r.Equal(decimal.NewFromInt(100000)) {
+		t.Errorf("Expected the context of the forked backend to round to 100000 got %s", r)
+	}
+	if !errors.Is(f.Err(), ErrLossyConversion) {
+		t.Errorf("Expected an inexact condition got %v", f.Err())
+	}
+	if err := b.Err(); err != nil {
+		t.Errorf("Expected no conditions of the fork in its parent got %v", err)
+	}
+
+	b.Div(decimal.NewFromInt(1), decimal.Zero)
+	if errors.Is(f.Err(), ErrDivisionByZero) {
+		t.Errorf("Expected no conditions of the parent in its fork got %v", f.Err())
+	}
+}
@@ -0,0 +1,110 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestDecimalBackend(t *testing.T) {
+	b := DecimalBackend{}
+	d := decimal.RequireFromString
+	max, min := decimal.NewFromInt(math.MaxInt64), decimal.NewFromInt(math.MinInt64)
+
+	tcs := []struct {
+		name     string
+		got      Amount
+		expected Amount
+	}{
+		{"add", b.Add(d("100"), d("-250")), d("-150")},
+		{"add overflow", b.Add(max, d("1")), d("9223372036854775808")},
+		{"add fraction", b.Add(d("0.5"), d("1")), d("1.5")},
+		{"sub", b.Sub(d("100"), d("250")), d("-150")},
+		{"sub overflow", b.Sub(min, d("1")), d("-9223372036854775809")},
+		{"mul", b.Mul(d("-12"), d("12")), d("-144")},
+		{"mul overflow", b.Mul(max, d("2")), d("18446744073709551614")},
+		{"mul min", b.Mul(d("-1"), min), d("9223372036854775808")},
+		{"mul fraction", b.Mul(d("100"), d("0.075")), d("7.5")},
+		{"div", b.Div(d("1"), d("4")), d("0.25")},
+	}
+
+	for _, tc := range tcs {
+		if !tc.got.Equal(tc.expected) {
+			t.Errorf("%s: expected %s got %s", tc.name, tc.expected, tc.got)
+		}
+	}
+
+	qrs := []struct {
+		a, b, q, r Amount
+	}{
+		{d("7"), d("2"), d("3"), d("1")},
+		{d("-7"), d("2"), d("-3"), d("-1")},
+		{d("7"), d("-2"), d("-3"), d("1")},
+		{min, d("-1"), d("9223372036854775808"), d("0")},
+		{d("7.5"), d("2"), d("3"), d("1.5")},
+	}
+
+	for _, tc := range qrs {
+		if q, r := b.QuoRem(tc.a, tc.b); !q.Equal(tc.q) || !r.Equal(tc.r) {
+			t.Errorf("Expected %s ÷ %s = %s remainder %s got %s remainder %s", tc.a, tc.b, tc.q, tc.r, q, r)
+		}
+	}
+}
+
+// countingBackend records how many operations go through it.
+type countingBackend struct {
+	DecimalBackend
+	calls int
+}
+
+func (b *countingBackend) Add(x, y Amount) Amount {
+	b.calls++
+	return b.DecimalBackend.Add(x, y)
+}
+
+func (b *countingBackend) Mul(x, y Amount) Amount {
+	b.calls++
+	return b.DecimalBackend.Mul(x, y)
+}
+
+func (b *countingBackend) QuoRem(x, y Amount) (Amount, Amount) {
+	b.calls++
+	return b.DecimalBackend.QuoRem(x, y)
+}
+
+func TestSetBackend(t *testing.T) {
+	b := &countingBackend{}
+	SetBackend(b)
+	defer SetBackend(nil)
+
+	m, err := New(100, EUR).Add(New(50, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != 150 || b.calls != 1 {
+		t.Errorf("Expected 150 from 1 backend call got %d from %d", m.Amount(), b.calls)
+	}
+
+	if r := NewFromDecimal(decimal.RequireFromString("1.005"), EUR).RoundToCurrency(); r.Amount() != 101 || b.calls < 2 {
+		t.Errorf("Expected rounding to go through the backend got %d from %d calls", r.Amount(), b.calls)
+	}
+
+	calls := b.calls
+	rates := StaticRateProvider{}.Add(EUR, USD, decimal.RequireFromString("1.1"))
+	if r, err := New(100, EUR).Convert(USD, rates); err != nil || r.Amount() != 110 || b.calls == calls {
+		t.Errorf("Expected conversion to go through the backend got %v %v from %d calls", r, err, b.calls-calls)
+	}
+
+	calls = b.calls
+	if _, err := OutliersZScore([]*Money{New(1, EUR), New(2, EUR)}, decimal.NewFromInt(3)); err != nil || b.calls == calls {
+		t.Errorf("Expected outliers to go through the backend got %v from %d calls", err, b.calls-calls)
+	}
+
+	SetBackend(nil)
+	calls = b.calls
+	if _, err := New(100, EUR).Add(New(50, EUR)); err != nil || b.calls != calls {
+		t.Errorf("Expected the default backend to be restored got %d calls", b.calls-calls)
+	}
+}
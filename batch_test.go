@@ -0,0 +1,82 @@
+package money
+
+import "testing"
+
+func TestBatch(t *testing.T) {
+	b := NewBatch(4)
+
+	ms := make([]*Money, 10)
+	for i := range ms {
+		ms[i] = b.New(int64(i), EUR)
+	}
+
+	if b.Len() != 10 {
+		t.Errorf("Expected %d got %d", 10, b.Len())
+	}
+
+	for i, m := range ms {
+		if m.Amount() != int64(i) || m.Currency().Code != EUR {
+			t.Errorf("Expected %d %s got %d %s", i, EUR, m.Amount(), m.Currency().Code)
+		}
+	}
+
+	if len(b.chunks) != 3 {
+		t.Errorf("Expected %d chunks got %d", 3, len(b.chunks))
+	}
+}
+
+func TestBatch_Release(t *testing.T) {
+	b := NewBatch(4)
+
+	kept := b.New(100, EUR)
+	clone := kept.Clone()
+	for i := 0; i < 5; i++ {
+		b.New(1, USD)
+	}
+
+	b.Release()
+	if b.Len() != 0 {
+		t.Errorf("Expected %d got %d", 0, b.Len())
+	}
+
+	for _, chunk := range b.chunks {
+		for _, m := range chunk {
+			if m != (Money{}) {
+				t.Errorf("Expected released memory to be cleared, got %v", m)
+			}
+		}
+	}
+
+	m := b.New(200, GBP)
+	if m != kept {
+		t.Error("Expected released memory to be reused")
+	}
+
+	if clone.Amount() != 100 || clone.Currency().Code != EUR {
+		t.Errorf("Expected clone to outlive the release, got %d %s", clone.Amount(), clone.Currency().Code)
+	}
+
+	if len(b.chunks) != 2 {
+		t.Errorf("Expected chunks to be reused, got %d", len(b.chunks))
+	}
+}
+
+func TestBatch_Allocations(t *testing.T) {
+	b := NewBatch(1024)
+	batched := testing.AllocsPerRun(100, func() {
+		for i := 0; i < 1024; i++ {
+			b.New(int64(i), EUR)
+		}
+		b.Release()
+	})
+
+	single := testing.AllocsPerRun(100, func() {
+		for i := 0; i < 1024; i++ {
+			New(int64(i), EUR)
+		}
+	})
+
+	if batched >= single {
+		t.Errorf("Expected fewer allocations with a batch, got %v against %v", batched, single)
+	}
+}
@@ -0,0 +1,82 @@
+package money
+
+import (
+	"encoding/binary"
+	"encoding/gob"
+)
+
+// ErrInvalidBinaryUnmarshal happens when binary data can't be decoded into Money.
+var ErrInvalidBinaryUnmarshal = newError("money.invalid_binary", "invalid binary unmarshal")
+
+// Versions of the binary encoding of Money, given by its first byte.
+const (
+	// binaryVersion is followed by the ordered amount and the currency code, up to the end of the data.
+	binaryVersion byte = 1
+	// binaryVersion2 is followed by the ordered amount, the length of the currency code as an uvarint,
+	// the code, and extension data left to later versions, which keep this layout and only append to it.
+	binaryVersion2 byte = 2
+)
+
+// BinaryVersion is the version of the binary encoding written by MarshalBinary. Version 1 is read by every
+// release; version 2 is self-delimiting, so readers skip data appended by later versions. Switch to version 2
+// once every reader of the encoded values supports it.
+var BinaryVersion = binaryVersion
+
+func init() {
+	gob.Register(Money{})
+}
+
+// MarshalBinary is implementation of encoding.BinaryMarshaler, also used by encoding/gob.
+// The amount is encoded exactly, including any precision beyond the currency fraction,
+// followed by the currency code. The zero value of Money is encoded as empty data, while Money in a currency
+// without code, which couldn't be decoded, returns ErrEmptyCurrencyCode.
+func (m Money) MarshalBinary() ([]byte, error) {
+	if m.currency == nil {
+		return []byte{}, nil
+	}
+
+	if m.currency.Code == "" {
+		return nil, ErrEmptyCurrencyCode
+	}
+
+	if BinaryVersion < binaryVersion2 {
+		b := append([]byte{binaryVersion}, encodeOrdered(m.amount)...)
+		return append(b, m.currency.Code...), nil
+	}
+
+	b := append([]byte{binaryVersion2}, encodeOrdered(m.amount)...)
+	var n [binary.MaxVarintLen64]byte
+	b = append(b, n[:binary.PutUvarint(n[:], uint64(len(m.currency.Code)))]...)
+	return append(b, m.currency.Code...), nil
+}
+
+// UnmarshalBinary is implementation of encoding.BinaryUnmarshaler, also used by encoding/gob.
+// It reads every version of the encoding, ignoring the extension data of versions after 2.
+func (m *Money) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		*m = Money{}
+		return nil
+	}
+
+	if b[0] < binaryVersion {
+		return ErrInvalidBinaryUnmarshal
+	}
+
+	amount, n, err := decodeOrdered(b[1:])
+	if err != nil || n+1 == len(b) {
+		return ErrInvalidBinaryUnmarshal
+	}
+
+	code := b[n+1:]
+	if b[0] >= binaryVersion2 {
+		l, k := binary.Uvarint(code)
+		if k <= 0 || l == 0 || l > uint64(len(code)-k) {
+			return ErrInvalidBinaryUnmarshal
+		}
+
+		code = code[k : k+int(l)]
+	}
+
+	*m = Money{amount: amount, currency: newCurrency(string(code)).get()}
+	return nil
+}
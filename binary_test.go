@@ -0,0 +1,136 @@
+package money
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Money{}
+	_ encoding.BinaryUnmarshaler = &Money{}
+)
+
+func TestMoney_BinaryRoundTrip(t *testing.T) {
+	ms := []*Money{
+		New(12345, USD),
+		New(-1, EUR),
+		New(0, JPY),
+		New(math.MaxInt64, BHD),
+		NewFromDecimal(decimal.RequireFromString("1.3459"), USD),
+		New(100, "XYZ"),
+	}
+
+	for _, m := range ms {
+		b, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Money
+		if err := got.UnmarshalBinary(b); err != nil {
+			t.Errorf("Unexpected error unmarshaling %s %s: %v", m.amount, m.Currency().Code, err)
+			continue
+		}
+
+		if !got.amount.Equal(m.amount) || got.Currency().Code != m.Currency().Code {
+			t.Errorf("Expected %s %s got %s %s", m.amount, m.Currency().Code, got.amount, got.Currency().Code)
+		}
+	}
+}
+
+func TestMoney_BinaryVersion2(t *testing.T) {
+	defer func() { BinaryVersion = binaryVersion }()
+	BinaryVersion = binaryVersion2
+
+	m := NewFromDecimal(decimal.RequireFromString("-1.3459"), USD)
+	b, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b[0] != binaryVersion2 || b[len(b)-4] != 3 {
+		t.Errorf("Expected version 2 with a length-prefixed code got %v", b)
+	}
+
+	var got Money
+	if err := got.UnmarshalBinary(b); err != nil || !got.amount.Equal(m.amount) || got.Currency().Code != USD {
+		t.Errorf("Expected %s %s got %s %v, %v", m.amount, USD, got.amount, got.Currency(), err)
+	}
+
+	future := append([]byte{9}, b[1:]...)
+	future = append(future, 0xde, 0xad)
+	if err := got.UnmarshalBinary(future); err != nil || !got.amount.Equal(m.amount) || got.Currency().Code != USD {
+		t.Errorf("Expected later versions to decode ignoring extensions got %s %v, %v", got.amount, got.Currency(), err)
+	}
+
+	for _, b := range [][]byte{b[:len(b)-1], b[:len(b)-4], append(append([]byte{}, b[:len(b)-4]...), 0, 'U')} {
+		if err := got.UnmarshalBinary(b); !errors.Is(err, ErrInvalidBinaryUnmarshal) {
+			t.Errorf("Expected %v unmarshaling %v got %v", ErrInvalidBinaryUnmarshal, b, err)
+		}
+	}
+}
+
+func TestMoney_BinaryZeroValue(t *testing.T) {
+	b, err := (Money{}).MarshalBinary()
+	if err != nil || len(b) != 0 {
+		t.Fatalf("Expected empty data for zero Money got %v %v", b, err)
+	}
+
+	m := *New(100, EUR)
+	if err := m.UnmarshalBinary(b); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero Money got %v %v", m, err)
+	}
+}
+
+func TestMoney_BinaryEmptyCode(t *testing.T) {
+	defer func() { BinaryVersion = binaryVersion }()
+
+	for _, v := range []byte{binaryVersion, binaryVersion2} {
+		BinaryVersion = v
+		if _, err := New(0, "").MarshalBinary(); !errors.Is(err, ErrEmptyCurrencyCode) {
+			t.Errorf("Expected %v for version %d got %v", ErrEmptyCurrencyCode, v, err)
+		}
+	}
+}
+
+func TestMoney_UnmarshalBinaryErrors(t *testing.T) {
+	valid, _ := New(100, EUR).MarshalBinary()
+
+	for _, b := range [][]byte{{0, cursorZero, 'E'}, {2}, {binaryVersion}, {binaryVersion, cursorZero}, valid[:len(valid)-3], {binaryVersion, 9, 'E'}} {
+		var m Money
+		if err := m.UnmarshalBinary(b); !errors.Is(err, ErrInvalidBinaryUnmarshal) {
+			t.Errorf("Expected %v unmarshaling %v got %v", ErrInvalidBinaryUnmarshal, b, err)
+		}
+	}
+}
+
+func TestMoney_Gob(t *testing.T) {
+	type payload struct {
+		Price Money
+		Any   interface{}
+	}
+
+	var buf bytes.Buffer
+	in := payload{Price: *New(-12345, EUR), Any: *New(500, JPY)}
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+
+	var out payload
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if eq, err := out.Price.Equals(&in.Price); err != nil || !eq {
+		t.Errorf("Expected %s got %s", in.Price.Display(), out.Price.Display())
+	}
+
+	if m, ok := out.Any.(Money); !ok || m.Display() != "¥500" {
+		t.Errorf("Expected %s got %v", "¥500", out.Any)
+	}
+}
@@ -0,0 +1,55 @@
+package money
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// bulkChunk is how many items bulk operations process between checks for cancellation of their context.
+const bulkChunk = 1024
+
+// ConvertAll converts all Money into the given currency like Convert, asking provider once per currency pair.
+// It checks ctx between chunks of Money, so long-running batch jobs can be aborted, and then returns ctx.Err().
+// Errors of a single conversion are returned with the index of the Money.
+func ConvertAll(ctx context.Context, ms []*Money, to string, provider RateProvider, mode ...RoundingMode) ([]*Money, error) {
+	rates := &cachedRates{provider: provider, rates: map[[2]string]decimal.Decimal{}}
+	out := make([]*Money, len(ms))
+	for i, m := range ms {
+		if i%bulkChunk == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		c, err := m.Convert(to, rates, mode...)
+		if err != nil {
+			return nil, fmt.Errorf("money %d: %w", i, err)
+		}
+		out[i] = c
+	}
+
+	return out, nil
+}
+
+// cachedRates is a RateProvider remembering the rates of another one for the duration of a bulk operation.
+type cachedRates struct {
+	provider RateProvider
+	rates    map[[2]string]decimal.Decimal
+}
+
+// Rate implements RateProvider.
+func (p *cachedRates) Rate(from, to string) (decimal.Decimal, error) {
+	if rate, ok := p.rates[[2]string{from, to}]; ok {
+		return rate, nil
+	}
+
+	rate, err := p.provider.Rate(from, to)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	p.rates[[2]string{from, to}] = rate
+	return rate, nil
+}
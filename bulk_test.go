@@ -0,0 +1,80 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// countingRates counts the rates asked to a StaticRateProvider and runs an optional hook on each.
+type countingRates struct {
+	StaticRateProvider
+	calls  int
+	onRate func()
+}
+
+func (p *countingRates) Rate(from, to string) (decimal.Decimal, error) {
+	p.calls++
+	if p.onRate != nil {
+		p.onRate()
+	}
+
+	return p.StaticRateProvider.Rate(from, to)
+}
+
+func TestConvertAll(t *testing.T) {
+	rates := &countingRates{StaticRateProvider: StaticRateProvider{}.
+		Add(EUR, USD, decimal.RequireFromString("1.1")).
+		Add(GBP, USD, decimal.RequireFromString("1.25"))}
+
+	ms := []*Money{New(100, EUR), New(200, GBP), New(1000, EUR), New(5, USD)}
+	out, err := ConvertAll(context.Background(), ms, USD, rates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int64{110, 250, 1100, 5}
+	for i, m := range out {
+		if m.Amount() != expected[i] || m.Currency().Code != USD {
+			t.Errorf("Expected %d USD got %d %s", expected[i], m.Amount(), m.Currency().Code)
+		}
+	}
+
+	if rates.calls != 2 {
+		t.Errorf("Expected one rate per currency pair got %d calls", rates.calls)
+	}
+
+	if _, err := ConvertAll(context.Background(), []*Money{New(1, USD), New(1, JPY)}, EUR, rates); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Expected %v got %v", ErrRateNotFound, err)
+	}
+}
+
+func TestConvertAll_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rates := &countingRates{StaticRateProvider: StaticRateProvider{}, onRate: cancel}
+	rates.Add(EUR, USD, decimal.NewFromInt(1))
+
+	ms := make([]*Money, 3*bulkChunk)
+	for i := range ms {
+		ms[i] = New(int64(i), EUR)
+	}
+
+	if _, err := ConvertAll(ctx, ms, USD, rates); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v got %v", context.Canceled, err)
+	}
+
+	if _, err := ConvertAll(ctx, ms[:1], USD, rates); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v got %v", context.Canceled, err)
+	}
+}
+
+func TestFromFloatColumnContext_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, _, err := FromFloatColumnContext(ctx, []float64{1, 2}, EUR); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v got %v", context.Canceled, err)
+	}
+}
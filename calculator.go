@@ -4,34 +4,50 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-type calculator struct{}
+// calculator implements the operations of Money on top of the primitives of a Backend.
+type calculator struct {
+	backend Backend
+}
 
 func (c *calculator) add(a, b Amount) Amount {
-	return a.Add(b)
+	return c.backend.Add(a, b)
 }
 
 func (c *calculator) subtract(a, b Amount) Amount {
-	return a.Sub(b)
+	return c.backend.Sub(a, b)
 }
 
 func (c *calculator) multiply(a Amount, m int64) Amount {
-	return a.Mul(decimal.NewFromInt(m))
+	if b, ok := c.backend.(DecimalBackend); ok {
+		return b.mulInt(a, m)
+	}
+
+	return c.backend.Mul(a, decimal.NewFromInt(m))
+}
+
+func (c *calculator) multiplyDecimal(a Amount, m decimal.Decimal) Amount {
+	return c.backend.Mul(a, m)
 }
 
 func (c *calculator) divide(a Amount, d int64) Amount {
-	return a.Div(decimal.NewFromInt(d))
+	return c.backend.Div(a, decimal.NewFromInt(d))
 }
 
 func (c *calculator) modulus(a Amount, d int64) Amount {
-	return a.Mod(decimal.NewFromInt(d))
+	_, r := c.backend.QuoRem(a, decimal.NewFromInt(d))
+	return r
+}
+
+func (c *calculator) quoRem(a, b Amount) (Amount, Amount) {
+	return c.backend.QuoRem(a, b)
 }
 
 func (c *calculator) allocate(a Amount, r, s int64) Amount {
 	if a.IsZero() || s == 0 {
 		return decimal.Zero
 	}
-	res := a.Mul(decimal.NewFromInt(r))
-	res = res.Div(decimal.NewFromInt(s))
+	res := c.backend.Mul(a, decimal.NewFromInt(r))
+	res = c.backend.Div(res, decimal.NewFromInt(s))
 	return res
 }
 
@@ -41,12 +57,53 @@ func (c *calculator) absolute(a Amount) Amount {
 
 func (c *calculator) negative(a Amount) Amount {
 	if a.IsPositive() {
-		return a.Mul(decimal.NewFromInt(-1))
+		return a.Neg()
 	}
 
 	return a
 }
 
-func (c *calculator) round(a Amount, e int) Amount {
-	return a.Round(int32(e * -1))
+// round rounds a to a multiple of 10^e using the given mode.
+func (c *calculator) round(a Amount, e int, mode RoundingMode) Amount {
+	return c.divideRound(a.Shift(int32(-e)), decimal.NewFromInt(1), mode).Shift(int32(e))
+}
+
+// divideRound divides a by d and rounds the quotient to an integer using the given mode.
+// The quotient is computed exactly, so ties are detected without any loss of precision.
+func (c *calculator) divideRound(a, d Amount, mode RoundingMode) Amount {
+	q, r := c.backend.QuoRem(a, d)
+	if r.IsZero() {
+		return q
+	}
+
+	// Direction away from zero of the exact quotient, and how its dropped fraction compares to one half.
+	step := decimal.NewFromInt(1)
+	if a.Sign() != d.Sign() {
+		step = step.Neg()
+	}
+	two := decimal.NewFromInt(2)
+	half := c.backend.Mul(r.Abs(), two).Cmp(d.Abs())
+
+	var away bool
+	switch mode {
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfDown:
+		away = half > 0
+	case RoundHalfEven:
+		_, odd := c.backend.QuoRem(q, two)
+		away = half > 0 || (half == 0 && !odd.IsZero())
+	case RoundFloor:
+		away = step.IsNegative()
+	case RoundCeiling:
+		away = step.IsPositive()
+	case RoundTruncate:
+		away = false
+	}
+
+	if away {
+		return c.backend.Add(q, step)
+	}
+
+	return q
 }
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// CanonicalMarshalJSON marshals Money into its canonical JSON form: keys sorted, no insignificant
+// whitespace and the amount written as an integer number of minor units, e.g. {"amount":12345,"currency":"USD"}.
+// The output is stable across releases, which makes it suitable for signed payloads such as webhooks.
+// Money carrying precision beyond the currency fraction has no such form and returns ErrLossyConversion.
+// It can be installed as the package-wide encoding:
+//
+//	money.MarshalJSON = money.CanonicalMarshalJSON
+func CanonicalMarshalJSON(m Money) ([]byte, error) {
+	if !m.amount.IsInteger() {
+		return nil, ErrLossyConversion
+	}
+
+	code := ""
+	if m.currency != nil {
+		code = m.currency.Code
+	}
+
+	b := make([]byte, 0, 40)
+	b = append(b, `{"amount":`...)
+	b = strconv.AppendInt(b, m.amount.IntPart(), 10)
+	b = append(b, `,"currency":`...)
+	c, err := marshalCanonical(code)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, c...)
+	b = append(b, '}')
+
+	return b, nil
+}
+
+// CanonicalJSONVersion is the version of the JSON encoding of Money written by VersionedCanonicalMarshalJSON.
+const CanonicalJSONVersion = 1
+
+// VersionedCanonicalMarshalJSON is like CanonicalMarshalJSON but also writes the version of the encoding,
+// e.g. {"amount":12345,"currency":"USD","version":1}, so long-lived values such as event logs and snapshots
+// tell which encoding they were written with. The default UnmarshalJSON reads both forms and ignores
+// fields it doesn't know, so values written by later versions still decode.
+func VersionedCanonicalMarshalJSON(m Money) ([]byte, error) {
+	b, err := CanonicalMarshalJSON(m)
+	if err != nil {
+		return nil, err
+	}
+
+	b = append(b[:len(b)-1], `,"version":`...)
+	b = strconv.AppendInt(b, CanonicalJSONVersion, 10)
+	return append(b, '}'), nil
+}
+
+// CanonicalJSON marshals any value into canonical JSON: object keys sorted at every level, no insignificant
+// whitespace, HTML characters left unescaped and numbers kept exactly as produced by their marshalers.
+// Use it to serialize whole payloads embedding Money before signing them, so a receiver that re-serializes
+// the payload gets the very same bytes.
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	var tree interface{}
+	if err := d.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	return marshalCanonical(tree)
+}
+
+// marshalCanonical encodes a decoded JSON tree; maps are written with sorted keys by encoding/json.
+func marshalCanonical(v interface{}) ([]byte, error) {
+	buff := &bytes.Buffer{}
+	e := json.NewEncoder(buff)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buff.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,91 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCanonicalMarshalJSON(t *testing.T) {
+	tcs := []struct {
+		money    Money
+		expected string
+	}{
+		{*New(12345, USD), `{"amount":12345,"currency":"USD"}`},
+		{*New(-1, EUR), `{"amount":-1,"currency":"EUR"}`},
+		{Money{}, `{"amount":0,"currency":""}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := CanonicalMarshalJSON(tc.money)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if string(b) != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, string(b))
+		}
+	}
+}
+
+func TestCanonicalMarshalJSON_Lossy(t *testing.T) {
+	if _, err := CanonicalMarshalJSON(*NewFromDecimal(decimal.RequireFromString("3.199"), USD)); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, err)
+	}
+}
+
+func TestCanonicalJSON(t *testing.T) {
+	MarshalJSON = CanonicalMarshalJSON
+	defer func() { MarshalJSON = defaultMarshalJSON }()
+
+	payload := map[string]interface{}{
+		"total":  New(12345, USD),
+		"event":  "invoice.paid",
+		"note":   "<b>&</b>",
+		"nested": map[string]interface{}{"z": 1.5, "a": []int{3, 2, 1}},
+	}
+
+	b, err := CanonicalJSON(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"event":"invoice.paid","nested":{"a":[3,2,1],"z":1.5},"note":"<b>&</b>","total":{"amount":12345,"currency":"USD"}}`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	again, err := CanonicalJSON(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(again) != string(b) {
+		t.Errorf("Expected stable output, got %s and %s", string(b), string(again))
+	}
+}
+
+func TestVersionedCanonicalMarshalJSON(t *testing.T) {
+	b, err := VersionedCanonicalMarshalJSON(*New(12345, USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"amount":12345,"currency":"USD","version":1}` {
+		t.Errorf("Expected %s got %s", `{"amount":12345,"currency":"USD","version":1}`, b)
+	}
+
+	for _, given := range []string{string(b), `{"amount":12345,"currency":"USD","version":7,"rate":"1.1"}`} {
+		var m Money
+		if err := defaultUnmarshalJSON(&m, []byte(given)); err != nil || m.Amount() != 12345 || m.Currency().Code != USD {
+			t.Errorf("Expected $123.45 from %s got %v, %v", given, m, err)
+		}
+	}
+
+	for _, given := range []string{`{"amount":1,"currency":"USD","version":0}`, `{"amount":1,"currency":"USD","version":"1"}`, `{"amount":1,"currency":"USD","version":1.5}`} {
+		var m Money
+		if err := defaultUnmarshalJSON(&m, []byte(given)); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+			t.Errorf("Expected ErrInvalidJSONUnmarshal for %s got %v", given, err)
+		}
+	}
+}
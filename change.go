@@ -0,0 +1,97 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Direction tells which way an amount changed.
+type Direction int
+
+const (
+	// DirectionNone means the amount didn't change.
+	DirectionNone Direction = iota
+	// DirectionUp means the amount increased.
+	DirectionUp
+	// DirectionDown means the amount decreased.
+	DirectionDown
+)
+
+var directionNames = map[Direction]string{
+	DirectionNone: "none",
+	DirectionUp:   "up",
+	DirectionDown: "down",
+}
+
+// String returns the name of the direction, e.g. "up".
+func (d Direction) String() string {
+	if name, ok := directionNames[d]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// changePlaces is the number of decimal places percent changes are rounded to.
+const changePlaces = 2
+
+// Change describes how Money changed from an old to a new amount in the same currency, with the strings
+// needed to show it, so that "price changed from X to Y (+Z)" banners read the same across apps.
+//
+// Percent is the change relative to the magnitude of the old amount, rounded half away from zero to two
+// decimal places, e.g. 12.5 for +12.5%. It is zero, and PercentDisplay empty, when the old amount is zero.
+type Change struct {
+	Old       *Money
+	New       *Money
+	Delta     *Money
+	Percent   decimal.Decimal
+	Direction Direction
+
+	OldDisplay     string
+	NewDisplay     string
+	DeltaDisplay   string
+	PercentDisplay string
+}
+
+// NewChange returns the change from the old Money to the new one, or ErrCurrencyMismatch if their currencies differ.
+// The delta is displayed with an explicit sign, e.g. "+€2.50" or "-€2.50", and the percentage likewise, e.g. "+25%".
+func NewChange(from, to *Money) (*Change, error) {
+	delta, err := to.Subtract(from)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Change{
+		Old:        from,
+		New:        to,
+		Delta:      delta,
+		OldDisplay: from.Display(),
+		NewDisplay: to.Display(),
+	}
+
+	sign := ""
+	switch {
+	case delta.IsPositive():
+		c.Direction, sign = DirectionUp, "+"
+	case delta.IsNegative():
+		c.Direction = DirectionDown
+	}
+	c.DeltaDisplay = sign + delta.Display()
+
+	if !from.IsZero() {
+		c.Percent = delta.Decimal().DivRound(from.Decimal().Abs(), changePlaces+2).Shift(2)
+		c.PercentDisplay = sign + c.Percent.String() + "%"
+	}
+
+	return c, nil
+}
+
+// String returns the change as text, e.g. "€10.00 → €12.50 (+€2.50, +25%)".
+func (c *Change) String() string {
+	if c.PercentDisplay == "" {
+		return fmt.Sprintf("%s → %s (%s)", c.OldDisplay, c.NewDisplay, c.DeltaDisplay)
+	}
+
+	return fmt.Sprintf("%s → %s (%s, %s)", c.OldDisplay, c.NewDisplay, c.DeltaDisplay, c.PercentDisplay)
+}
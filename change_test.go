@@ -0,0 +1,42 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewChange(t *testing.T) {
+	tcs := []struct {
+		from, to  *Money
+		direction Direction
+		percent   string
+		str       string
+	}{
+		{New(1000, EUR), New(1250, EUR), DirectionUp, "25", "€10.00 → €12.50 (+€2.50, +25%)"},
+		{New(1250, EUR), New(1000, EUR), DirectionDown, "-20", "€12.50 → €10.00 (-€2.50, -20%)"},
+		{New(300, EUR), New(400, EUR), DirectionUp, "33.33", "€3.00 → €4.00 (+€1.00, +33.33%)"},
+		{New(300, EUR), New(200, EUR), DirectionDown, "-33.33", "€3.00 → €2.00 (-€1.00, -33.33%)"},
+		{New(999, EUR), New(999, EUR), DirectionNone, "0", "€9.99 → €9.99 (€0.00, 0%)"},
+		{New(-1000, EUR), New(-500, EUR), DirectionUp, "50", "-€10.00 → -€5.00 (+€5.00, +50%)"},
+		{New(0, EUR), New(500, EUR), DirectionUp, "0", "€0.00 → €5.00 (+€5.00)"},
+	}
+
+	for _, tc := range tcs {
+		c, err := NewChange(tc.from, tc.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Direction != tc.direction || c.Percent.String() != tc.percent || c.String() != tc.str {
+			t.Errorf("Expected %s %s%% %q got %s %s%% %q", tc.direction, tc.percent, tc.str, c.Direction, c.Percent, c.String())
+		}
+
+		if sum, _ := c.Old.Add(c.Delta); sum.amount.Cmp(c.New.amount) != 0 {
+			t.Errorf("Expected old plus delta to be %s got %s", c.NewDisplay, sum.Display())
+		}
+	}
+
+	if _, err := NewChange(New(100, EUR), New(100, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+// Command money provides command line tools for everyday money operations built on go-money.
+//
+// Usage:
+//
+//	money reconcile [flags] left.csv right.csv
+//
+// Run "money <command> -h" for the flags of a command.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Exit codes follow diff: 0 when there is nothing to report, 1 when differences were found, 2 on errors.
+const (
+	exitOK = iota
+	exitDiff
+	exitError
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitError
+	}
+
+	switch args[0] {
+	case "reconcile":
+		return reconcile(args[1:], stdout, stderr)
+	case "help", "-h", "--help":
+		usage(stdout)
+		return exitOK
+	}
+
+	fmt.Fprintf(stderr, "money: unknown command %q\n", args[0])
+	usage(stderr)
+	return exitError
+}
+
+func usage(w io.Writer) {
+	fmt.Fprint(w, `Usage: money <command> [flags] [arguments]
+
+Commands:
+  reconcile  compare two CSV files of id, amount and currency and report mismatches
+`)
+}
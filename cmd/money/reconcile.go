@@ -0,0 +1,240 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	money "github.com/noho-digital/go-money"
+	"github.com/shopspring/decimal"
+)
+
+// Mismatch statuses reported by reconcile.
+const (
+	statusMissingLeft      = "missing_left"
+	statusMissingRight     = "missing_right"
+	statusCurrencyMismatch = "currency_mismatch"
+	statusAmountMismatch   = "amount_mismatch"
+)
+
+// mismatch is one line of the reconcile report. Amounts are in major units; the difference is right minus left.
+type mismatch struct {
+	ID            string `json:"id"`
+	Status        string `json:"status"`
+	LeftAmount    string `json:"left_amount,omitempty"`
+	LeftCurrency  string `json:"left_currency,omitempty"`
+	RightAmount   string `json:"right_amount,omitempty"`
+	RightCurrency string `json:"right_currency,omitempty"`
+	Difference    string `json:"difference,omitempty"`
+}
+
+// record is one row of an input file.
+type record struct {
+	id    string
+	money *money.Money
+}
+
+func reconcile(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, `Usage: money reconcile [flags] left.csv right.csv
+
+Compares two CSV files of id, amount and currency rows, such as a ledger export and a bank statement,
+and writes a report of every id missing from either side or whose Money differs. Amounts are in major
+units with a "." decimal separator, e.g. 1234.56. Exits 1 when mismatches were found.
+
+Flags:
+`)
+		fs.PrintDefaults()
+	}
+
+	tolerance := fs.String("tolerance", "0", "largest accepted difference between amounts, in major units")
+	format := fs.String("format", "csv", "report format: csv or json (one object per line)")
+	header := fs.Bool("header", true, "skip the first row of each file")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return exitError
+	}
+
+	tol, err := decimal.NewFromString(*tolerance)
+	if err != nil || tol.IsNegative() {
+		fmt.Fprintf(stderr, "money reconcile: invalid tolerance %q\n", *tolerance)
+		return exitError
+	}
+
+	left, err := readFile(fs.Arg(0), *header)
+	if err != nil {
+		fmt.Fprintf(stderr, "money reconcile: %v\n", err)
+		return exitError
+	}
+
+	right, err := readFile(fs.Arg(1), *header)
+	if err != nil {
+		fmt.Fprintf(stderr, "money reconcile: %v\n", err)
+		return exitError
+	}
+
+	write, flush, err := reportWriter(*format, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "money reconcile: %v\n", err)
+		return exitError
+	}
+
+	mismatches := compare(left, right, tol)
+	for _, m := range mismatches {
+		if err := write(m); err != nil {
+			fmt.Fprintf(stderr, "money reconcile: %v\n", err)
+			return exitError
+		}
+	}
+
+	if err := flush(); err != nil {
+		fmt.Fprintf(stderr, "money reconcile: %v\n", err)
+		return exitError
+	}
+
+	if len(mismatches) > 0 {
+		return exitDiff
+	}
+
+	return exitOK
+}
+
+// compare returns the mismatches between left and right, in the order of left followed by ids only found in right.
+func compare(left, right []record, tolerance decimal.Decimal) []mismatch {
+	byID := make(map[string]*money.Money, len(right))
+	for _, r := range right {
+		byID[r.id] = r.money
+	}
+
+	var out []mismatch
+	seen := make(map[string]bool, len(left))
+	for _, l := range left {
+		seen[l.id] = true
+
+		r, ok := byID[l.id]
+		if !ok {
+			out = append(out, newMismatch(l.id, statusMissingRight, l.money, nil))
+			continue
+		}
+
+		if !l.money.SameCurrency(r) {
+			out = append(out, newMismatch(l.id, statusCurrencyMismatch, l.money, r))
+			continue
+		}
+
+		diff := r.Decimal().Sub(l.money.Decimal())
+		if diff.Abs().GreaterThan(tolerance) {
+			m := newMismatch(l.id, statusAmountMismatch, l.money, r)
+			m.Difference = diff.StringFixed(int32(r.Currency().Fraction))
+			out = append(out, m)
+		}
+	}
+
+	for _, r := range right {
+		if !seen[r.id] {
+			out = append(out, newMismatch(r.id, statusMissingLeft, nil, r.money))
+		}
+	}
+
+	return out
+}
+
+func newMismatch(id, status string, left, right *money.Money) mismatch {
+	m := mismatch{ID: id, Status: status}
+	if left != nil {
+		m.LeftAmount, m.LeftCurrency = left.MachineString(), left.Currency().Code
+	}
+
+	if right != nil {
+		m.RightAmount, m.RightCurrency = right.MachineString(), right.Currency().Code
+	}
+
+	return m
+}
+
+func readFile(name string, header bool) ([]record, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := readRecords(f, header)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return records, nil
+}
+
+// readRecords reads id, amount and currency rows. Ids must be unique and amounts must not be more precise
+// than their currency.
+func readRecords(r io.Reader, header bool) ([]record, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 3
+	cr.TrimLeadingSpace = true
+
+	var records []record
+	seen := map[string]bool{}
+	for line := 1; ; line++ {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if header && line == 1 {
+			continue
+		}
+
+		if seen[row[0]] {
+			return nil, fmt.Errorf("line %d: duplicate id %q", line, row[0])
+		}
+		seen[row[0]] = true
+
+		m, err := money.NewFromString(row[1], row[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		records = append(records, record{id: row[0], money: m})
+	}
+}
+
+// reportWriter returns functions writing mismatches in the given format and flushing the report.
+func reportWriter(format string, w io.Writer) (func(mismatch) error, func() error, error) {
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"id", "status", "left_amount", "left_currency", "right_amount", "right_currency", "difference"}); err != nil {
+			return nil, nil, err
+		}
+
+		write := func(m mismatch) error {
+			return cw.Write([]string{m.ID, m.Status, m.LeftAmount, m.LeftCurrency, m.RightAmount, m.RightCurrency, m.Difference})
+		}
+		flush := func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+		return write, flush, nil
+	case "json":
+		enc := json.NewEncoder(w)
+		return func(m mismatch) error { return enc.Encode(m) }, func() error { return nil }, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown format %q", format)
+}
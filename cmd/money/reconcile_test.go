@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeCSV(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	p := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(p, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func tempDir(t *testing.T) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "reconcile")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func TestReconcile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	left := writeCSV(t, dir, "left.csv", `id,amount,currency
+1,10.00,EUR
+2,20.00,EUR
+3,30.00,EUR
+4,40,JPY
+5,50.00,USD
+`)
+	right := writeCSV(t, dir, "right.csv", `id,amount,currency
+5,50.00,EUR
+4,40,JPY
+3,30.01,EUR
+2,20.50,EUR
+6,60.00,GBP
+`)
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"reconcile", "-tolerance", "0.01", left, right}, &stdout, &stderr)
+	if code != exitDiff {
+		t.Fatalf("Expected exit code %d got %d: %s", exitDiff, code, stderr.String())
+	}
+
+	expected := `id,status,left_amount,left_currency,right_amount,right_currency,difference
+1,missing_right,10.00,EUR,,,
+2,amount_mismatch,20.00,EUR,20.50,EUR,0.50
+5,currency_mismatch,50.00,USD,50.00,EUR,
+6,missing_left,,,60.00,GBP,
+`
+	if stdout.String() != expected {
+		t.Errorf("Expected report\n%s\ngot\n%s", expected, stdout.String())
+	}
+
+	stdout.Reset()
+	code = run([]string{"reconcile", "-format", "json", left, right}, &stdout, &stderr)
+	if code != exitDiff {
+		t.Fatalf("Expected exit code %d got %d: %s", exitDiff, code, stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 mismatches got %d: %s", len(lines), stdout.String())
+	}
+
+	if lines[2] != `{"id":"3","status":"amount_mismatch","left_amount":"30.00","left_currency":"EUR","right_amount":"30.01","right_currency":"EUR","difference":"0.01"}` {
+		t.Errorf("Unexpected mismatch %s", lines[2])
+	}
+}
+
+func TestReconcile_NoMismatch(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	left := writeCSV(t, dir, "left.csv", "1,10.00,EUR\n2,-5,EUR\n")
+	right := writeCSV(t, dir, "right.csv", "2,-5.00,EUR\n1,10,EUR\n")
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"reconcile", "-header=false", left, right}, &stdout, &stderr); code != exitOK {
+		t.Fatalf("Expected exit code %d got %d: %s", exitOK, code, stderr.String())
+	}
+
+	if stdout.String() != "id,status,left_amount,left_currency,right_amount,right_currency,difference\n" {
+		t.Errorf("Expected an empty report got %s", stdout.String())
+	}
+}
+
+func TestReconcile_Errors(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	valid := writeCSV(t, dir, "valid.csv", "id,amount,currency\n1,10.00,EUR\n")
+
+	tcs := []struct {
+		name  string
+		args  []string
+		input string
+	}{
+		{"no files", []string{"reconcile"}, ""},
+		{"invalid tolerance", []string{"reconcile", "-tolerance", "-1"}, "id,amount,currency\n"},
+		{"invalid format", []string{"reconcile", "-format", "xml"}, "id,amount,currency\n"},
+		{"duplicate id", []string{"reconcile"}, "id,amount,currency\n1,1,EUR\n1,2,EUR\n"},
+		{"too precise", []string{"reconcile"}, "id,amount,currency\n1,1.001,EUR\n"},
+		{"invalid amount", []string{"reconcile"}, "id,amount,currency\n1,ten,EUR\n"},
+		{"missing field", []string{"reconcile"}, "id,amount,currency\n1,10\n"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			args := tc.args
+			if tc.input != "" {
+				args = append(args, writeCSV(t, dir, "input.csv", tc.input), valid)
+			}
+
+			var stdout, stderr bytes.Buffer
+			if code := run(args, &stdout, &stderr); code != exitError {
+				t.Errorf("Expected exit code %d got %d", exitError, code)
+			}
+		})
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"unknown"}, &stdout, &stderr); code != exitError {
+		t.Errorf("Expected exit code %d got %d", exitError, code)
+	}
+}
@@ -0,0 +1,43 @@
+package money
+
+// CodePosition selects on which side of the amount a CodeFormat puts the ISO code.
+type CodePosition int
+
+const (
+	// CodeAfter puts the code after the amount, e.g. "12.34 EUR", as preferred in back-office reports.
+	CodeAfter CodePosition = iota
+	// CodeBefore puts the code before the amount, e.g. "EUR 12.34", as preferred by APIs.
+	CodeBefore
+)
+
+// CodeFormat displays Money with its ISO 4217 code instead of its symbol. The amount follows the conventions
+// of the currency, and Separator is put between the amount and the code. The zero value gives "12.34EUR".
+type CodeFormat struct {
+	Position  CodePosition
+	Separator string
+}
+
+var (
+	// TrailingCode displays Money with its code after the amount, separated by a space: "1,234.56 EUR".
+	TrailingCode = CodeFormat{Position: CodeAfter, Separator: " "}
+	// LeadingCode displays Money with its code before the amount, separated by a space: "EUR 1,234.56".
+	LeadingCode = CodeFormat{Position: CodeBefore, Separator: " "}
+)
+
+// Format returns Money displayed with its code in the format, such as "-1,234.56 EUR" or "EUR -1,234.56".
+func (f CodeFormat) Format(m *Money) string {
+	c := m.currency
+	amount := NewFormatter(c.Fraction, c.Decimal, c.Thousand, "", "1").Format(m.amount.IntPart())
+
+	if f.Position == CodeBefore {
+		return c.Code + f.Separator + amount
+	}
+
+	return amount + f.Separator + c.Code
+}
+
+// DisplayCode lets represent Money struct as string with its ISO code in the given format, e.g. "12.34 EUR"
+// with TrailingCode or "EUR 12.34" with LeadingCode.
+func (m *Money) DisplayCode(f CodeFormat) string {
+	return f.Format(m)
+}
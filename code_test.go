@@ -0,0 +1,42 @@
+package money
+
+import "testing"
+
+func TestMoney_DisplayCode(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		format   CodeFormat
+		expected string
+	}{
+		{New(123456, EUR), TrailingCode, "1,234.56 EUR"},
+		{New(123456, EUR), LeadingCode, "EUR 1,234.56"},
+		{New(-123456, EUR), TrailingCode, "-1,234.56 EUR"},
+		{New(-123456, EUR), LeadingCode, "EUR -1,234.56"},
+		{New(1234, EUR), CodeFormat{}, "12.34EUR"},
+		{New(1234, EUR), CodeFormat{Position: CodeBefore, Separator: " "}, "EUR 12.34"},
+		{New(1234, JPY), TrailingCode, "1,234 JPY"},
+		{New(123456, BHD), LeadingCode, "BHD 123.456"},
+		{New(1234, "XYZ"), TrailingCode, "12.34 XYZ"},
+	}
+
+	for _, tc := range tcs {
+		if got := tc.money.DisplayCode(tc.format); got != tc.expected {
+			t.Errorf("Expected %q got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestMoney_DisplayCodeParse(t *testing.T) {
+	m := New(-123456, EUR)
+
+	for _, f := range []CodeFormat{TrailingCode, LeadingCode} {
+		p, err := Parse(m.DisplayCode(f))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ok, _ := p.Equals(m); !ok {
+			t.Errorf("Expected %s got %s", m.Display(), p.Display())
+		}
+	}
+}
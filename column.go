@@ -0,0 +1,145 @@
+package money
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// MoneyColumn is a columnar representation of many Money values in a single currency, for analytic workloads
+// where a *Money per value is too slow. Value i is Values[i] × 10^Exponent minor units of Currency; the exponent
+// is zero unless the values carry precision beyond the currency fraction. Operations run in tight loops over
+// the int64 values, which the compiler can unroll and vectorize.
+type MoneyColumn struct {
+	Values   []int64
+	Currency *Currency
+	Exponent int32
+}
+
+// NewMoneyColumn creates a MoneyColumn of the given amounts in minor units of the currency with the given code.
+func NewMoneyColumn(code string, values []int64) *MoneyColumn {
+	return &MoneyColumn{Values: values, Currency: newCurrency(code).get()}
+}
+
+// MoneyColumnOf creates a MoneyColumn from the given Money. All Money must share a currency, otherwise
+// ErrCurrencyMismatch is returned; an empty list returns ErrNoMoney. The exponent is chosen so that every
+// amount is represented exactly, and ErrOverflow is returned when one of them doesn't fit an int64 then.
+func MoneyColumnOf(ms ...*Money) (*MoneyColumn, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoMoney
+	}
+
+	var exp int32
+	for _, m := range ms {
+		if err := ms[0].assertSameCurrency(m); err != nil {
+			return nil, err
+		}
+
+		if e := m.amount.Exponent(); e < exp && !m.amount.IsInteger() {
+			exp = e
+		}
+	}
+
+	c := &MoneyColumn{Values: make([]int64, len(ms)), Currency: ms[0].currency, Exponent: exp}
+	for i, m := range ms {
+		v := m.amount.Shift(-exp)
+		if !v.BigInt().IsInt64() {
+			return nil, ErrOverflow
+		}
+		c.Values[i] = v.IntPart()
+	}
+
+	return c, nil
+}
+
+// Len returns the number of values in the column.
+func (c *MoneyColumn) Len() int {
+	return len(c.Values)
+}
+
+// Money returns the values of the column as a slice of Money.
+func (c *MoneyColumn) Money() []*Money {
+	ms := make([]*Money, len(c.Values))
+	for i, v := range c.Values {
+		ms[i] = c.at(v)
+	}
+
+	return ms
+}
+
+// Sum returns new Money struct with value representing the sum of the column, zero for an empty column.
+// The sum is exact; it is computed in int64 and only falls back to decimals when that overflows.
+func (c *MoneyColumn) Sum() *Money {
+	var sum int64
+	for _, v := range c.Values {
+		s := sum + v
+		if (v > 0 && s < sum) || (v < 0 && s > sum) {
+			return c.sumDecimal()
+		}
+		sum = s
+	}
+
+	return c.at(sum)
+}
+
+// Min returns the smallest value of the column, or ErrNoMoney for an empty column.
+func (c *MoneyColumn) Min() (*Money, error) {
+	if len(c.Values) == 0 {
+		return nil, ErrNoMoney
+	}
+
+	min := c.Values[0]
+	for _, v := range c.Values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+
+	return c.at(min), nil
+}
+
+// Max returns the largest value of the column, or ErrNoMoney for an empty column.
+func (c *MoneyColumn) Max() (*Money, error) {
+	if len(c.Values) == 0 {
+		return nil, ErrNoMoney
+	}
+
+	max := c.Values[0]
+	for _, v := range c.Values[1:] {
+		if v > max {
+			max = v
+		}
+	}
+
+	return c.at(max), nil
+}
+
+// Scale returns a new MoneyColumn with every value multiplied by the given factor,
+// or ErrOverflow when one of the products doesn't fit an int64.
+func (c *MoneyColumn) Scale(factor int64) (*MoneyColumn, error) {
+	values := make([]int64, len(c.Values))
+	for i, v := range c.Values {
+		p := v * factor
+		if v != 0 && (p/v != factor || (v == -1 && factor == math.MinInt64)) {
+			return nil, ErrOverflow
+		}
+		values[i] = p
+	}
+
+	return &MoneyColumn{Values: values, Currency: c.Currency, Exponent: c.Exponent}, nil
+}
+
+// at returns the given column value as Money.
+func (c *MoneyColumn) at(v int64) *Money {
+	return &Money{amount: decimal.New(v, c.Exponent), currency: c.Currency}
+}
+
+// sumDecimal sums the column in decimals, for sums that overflow an int64.
+func (c *MoneyColumn) sumDecimal() *Money {
+	sum := decimal.Zero
+	for _, v := range c.Values {
+		sum = mutate.calc.add(sum, decimal.NewFromInt(v))
+	}
+
+	return &Money{amount: sum.Shift(c.Exponent), currency: c.Currency}
+}
@@ -0,0 +1,111 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoneyColumn(t *testing.T) {
+	c := NewMoneyColumn(EUR, []int64{100, -250, 1000, 7})
+
+	if c.Len() != 4 {
+		t.Errorf("Expected %d got %d", 4, c.Len())
+	}
+
+	if sum := c.Sum(); sum.Amount() != 857 || sum.Currency().Code != EUR {
+		t.Errorf("Expected %d %s got %d %s", 857, EUR, sum.Amount(), sum.Currency().Code)
+	}
+
+	if min, err := c.Min(); err != nil || min.Amount() != -250 {
+		t.Errorf("Expected %d got %v %v", -250, min, err)
+	}
+
+	if max, err := c.Max(); err != nil || max.Amount() != 1000 {
+		t.Errorf("Expected %d got %v %v", 1000, max, err)
+	}
+
+	scaled, err := c.Scale(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if scaled.Values[1] != -750 || c.Values[1] != -250 {
+		t.Errorf("Expected scaled copy %d and unchanged %d got %d and %d", -750, -250, scaled.Values[1], c.Values[1])
+	}
+}
+
+func TestMoneyColumn_Empty(t *testing.T) {
+	c := NewMoneyColumn(EUR, nil)
+
+	if sum := c.Sum(); !sum.IsZero() {
+		t.Errorf("Expected zero got %d", sum.Amount())
+	}
+
+	if _, err := c.Min(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := c.Max(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+}
+
+func TestMoneyColumn_Overflow(t *testing.T) {
+	c := NewMoneyColumn(EUR, []int64{math.MaxInt64, 1, math.MaxInt64})
+
+	expected := decimal.NewFromInt(math.MaxInt64).Mul(decimal.NewFromInt(2)).Add(decimal.NewFromInt(1))
+	if sum := c.Sum(); !sum.amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, sum.amount)
+	}
+
+	if _, err := c.Scale(2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := NewMoneyColumn(EUR, []int64{math.MinInt64}).Scale(-1); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+
+	if _, err := NewMoneyColumn(EUR, []int64{-1}).Scale(math.MinInt64); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestMoneyColumnOf(t *testing.T) {
+	ms := []*Money{New(100, USD), NewFromDecimal(decimal.RequireFromString("0.015"), USD), New(-3, USD)}
+
+	c, err := MoneyColumnOf(ms...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Exponent != -1 || c.Values[0] != 1000 || c.Values[1] != 15 || c.Values[2] != -30 {
+		t.Errorf("Expected values %v at exponent %d got %v at %d", []int64{1000, 15, -30}, -1, c.Values, c.Exponent)
+	}
+
+	for i, m := range c.Money() {
+		if !m.amount.Equal(ms[i].amount) || m.Currency().Code != USD {
+			t.Errorf("Expected %s %s got %s %s", ms[i].amount, USD, m.amount, m.Currency().Code)
+		}
+	}
+
+	if sum := c.Sum(); !sum.amount.Equal(decimal.RequireFromString("98.5")) {
+		t.Errorf("Expected %s got %s", "98.5", sum.amount)
+	}
+
+	if _, err := MoneyColumnOf(); !errors.Is(err, ErrNoMoney) {
+		t.Errorf("Expected %v got %v", ErrNoMoney, err)
+	}
+
+	if _, err := MoneyColumnOf(New(1, USD), New(1, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	big := NewFromDecimal(decimal.RequireFromString("1e30"), USD)
+	if _, err := MoneyColumnOf(big); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
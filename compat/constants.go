@@ -0,0 +1,184 @@
+package compat
+
+import money "github.com/noho-digital/go-money"
+
+// Constants for active currency codes according to the ISO 4217 standard.
+const (
+	AED = money.AED
+	AFN = money.AFN
+	ALL = money.ALL
+	AMD = money.AMD
+	ANG = money.ANG
+	AOA = money.AOA
+	ARS = money.ARS
+	AUD = money.AUD
+	AWG = money.AWG
+	AZN = money.AZN
+	BAM = money.BAM
+	BBD = money.BBD
+	BDT = money.BDT
+	BGN = money.BGN
+	BHD = money.BHD
+	BIF = money.BIF
+	BMD = money.BMD
+	BND = money.BND
+	BOB = money.BOB
+	BRL = money.BRL
+	BSD = money.BSD
+	BTN = money.BTN
+	BWP = money.BWP
+	BYN = money.BYN
+	BYR = money.BYR
+	BZD = money.BZD
+	CAD = money.CAD
+	CDF = money.CDF
+	CHF = money.CHF
+	CLF = money.CLF
+	CLP = money.CLP
+	CNY = money.CNY
+	COP = money.COP
+	CRC = money.CRC
+	CUC = money.CUC
+	CUP = money.CUP
+	CVE = money.CVE
+	CZK = money.CZK
+	DJF = money.DJF
+	DKK = money.DKK
+	DOP = money.DOP
+	DZD = money.DZD
+	EEK = money.EEK
+	EGP = money.EGP
+	ERN = money.ERN
+	ETB = money.ETB
+	EUR = money.EUR
+	FJD = money.FJD
+	FKP = money.FKP
+	GBP = money.GBP
+	GEL = money.GEL
+	GGP = money.GGP
+	GHC = money.GHC
+	GHS = money.GHS
+	GIP = money.GIP
+	GMD = money.GMD
+	GNF = money.GNF
+	GTQ = money.GTQ
+	GYD = money.GYD
+	HKD = money.HKD
+	HNL = money.HNL
+	HRK = money.HRK
+	HTG = money.HTG
+	HUF = money.HUF
+	IDR = money.IDR
+	ILS = money.ILS
+	IMP = money.IMP
+	INR = money.INR
+	IQD = money.IQD
+	IRR = money.IRR
+	ISK = money.ISK
+	JEP = money.JEP
+	JMD = money.JMD
+	JOD = money.JOD
+	JPY = money.JPY
+	KES = money.KES
+	KGS = money.KGS
+	KHR = money.KHR
+	KMF = money.KMF
+	KPW = money.KPW
+	KRW = money.KRW
+	KWD = money.KWD
+	KYD = money.KYD
+	KZT = money.KZT
+	LAK = money.LAK
+	LBP = money.LBP
+	LKR = money.LKR
+	LRD = money.LRD
+	LSL = money.LSL
+	LTL = money.LTL
+	LVL = money.LVL
+	LYD = money.LYD
+	MAD = money.MAD
+	MDL = money.MDL
+	MGA = money.MGA
+	MKD = money.MKD
+	MMK = money.MMK
+	MNT = money.MNT
+	MOP = money.MOP
+	MUR = money.MUR
+	MRU = money.MRU
+	MVR = money.MVR
+	MWK = money.MWK
+	MXN = money.MXN
+	MYR = money.MYR
+	MZN = money.MZN
+	NAD = money.NAD
+	NGN = money.NGN
+	NIO = money.NIO
+	NOK = money.NOK
+	NPR = money.NPR
+	NZD = money.NZD
+	OMR = money.OMR
+	PAB = money.PAB
+	PEN = money.PEN
+	PGK = money.PGK
+	PHP = money.PHP
+	PKR = money.PKR
+	PLN = money.PLN
+	PYG = money.PYG
+	QAR = money.QAR
+	RON = money.RON
+	RSD = money.RSD
+	RUB = money.RUB
+	RUR = money.RUR
+	RWF = money.RWF
+	SAR = money.SAR
+	SBD = money.SBD
+	SCR = money.SCR
+	SDG = money.SDG
+	SEK = money.SEK
+	SGD = money.SGD
+	SHP = money.SHP
+	SKK = money.SKK
+	SLE = money.SLE
+	SLL = money.SLL
+	SOS = money.SOS
+	SRD = money.SRD
+	SSP = money.SSP
+	STD = money.STD
+	STN = money.STN
+	SVC = money.SVC
+	SYP = money.SYP
+	SZL = money.SZL
+	THB = money.THB
+	TJS = money.TJS
+	TMT = money.TMT
+	TND = money.TND
+	TOP = money.TOP
+	TRL = money.TRL
+	TRY = money.TRY
+	TTD = money.TTD
+	TWD = money.TWD
+	TZS = money.TZS
+	UAH = money.UAH
+	UGX = money.UGX
+	USD = money.USD
+	UYU = money.UYU
+	UZS = money.UZS
+	VEF = money.VEF
+	VES = money.VES
+	VND = money.VND
+	VUV = money.VUV
+	WST = money.WST
+	XAF = money.XAF
+	XAG = money.XAG
+	XAU = money.XAU
+	XCD = money.XCD
+	XCG = money.XCG
+	XDR = money.XDR
+	XOF = money.XOF
+	XPF = money.XPF
+	YER = money.YER
+	ZAR = money.ZAR
+	ZMW = money.ZMW
+	ZWD = money.ZWD
+	ZWL = money.ZWL
+)
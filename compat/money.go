@@ -0,0 +1,314 @@
+// Package compat mirrors the API of the upstream github.com/Rhymond/go-money package, with int64 amounts and
+// the exact upstream signatures, on top of the decimal-backed github.com/noho-digital/go-money.
+//
+// It lets a large codebase switch its imports first and adopt the decimal-native API incrementally:
+//
+//	import money "github.com/noho-digital/go-money/compat"
+//
+// Wrap and Unwrap convert between the two Money types at the boundary of migrated code.
+package compat
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	money "github.com/noho-digital/go-money"
+)
+
+// Injection points for backward compatibility.
+// If you need to keep your JSON marshal/unmarshal way, overwrite them like below.
+//
+//	money.UnmarshalJSON = func (m *Money, b []byte) error { ... }
+//	money.MarshalJSON = func (m Money) ([]byte, error) { ... }
+//
+// The defaults use the hooks of the decimal-backed package, so both APIs encode Money the same way.
+var (
+	// UnmarshalJSON is injection point of json.Unmarshaller for compat.Money
+	UnmarshalJSON = defaultUnmarshalJSON
+	// MarshalJSON is injection point of json.Marshaller for compat.Money
+	MarshalJSON = defaultMarshalJSON
+
+	// ErrCurrencyMismatch happens when two compared Money don't have the same currency.
+	ErrCurrencyMismatch = money.ErrCurrencyMismatch
+
+	// ErrInvalidJSONUnmarshal happens when the default compat.UnmarshalJSON fails to unmarshal Money because of invalid data.
+	ErrInvalidJSONUnmarshal = money.ErrInvalidJSONUnmarshal
+
+	// DBMoneyValueSeparator is used to join together the Amount and Currency components of compat.Money instances
+	// allowing them to be stored as strings (via the driver.Valuer interface) and unmarshalled as strings (via
+	// the sql.Scanner interface); set this value to use a different separator.
+	DBMoneyValueSeparator = money.DefaultDBMoneyValueSeparator
+)
+
+func defaultUnmarshalJSON(m *Money, b []byte) error {
+	return m.m.UnmarshalJSON(b)
+}
+
+func defaultMarshalJSON(m Money) ([]byte, error) {
+	return m.m.MarshalJSON()
+}
+
+// Amount is a data structure that stores the amount being used for calculations.
+type Amount = int64
+
+// Currency represents money currency information required for formatting.
+type Currency = money.Currency
+
+// Currencies represents a collection of currency.
+type Currencies = money.Currencies
+
+// Formatter stores Money formatting information.
+type Formatter = money.Formatter
+
+// Money represents monetary value information, stores
+// currency and amount value.
+type Money struct {
+	m money.Money
+}
+
+// New creates and returns new instance of Money.
+func New(amount int64, code string) *Money {
+	return Wrap(money.New(amount, code))
+}
+
+// NewFromFloat creates and returns new instance of Money from a float64.
+// Always rounding trailing decimals down.
+func NewFromFloat(amount float64, code string) *Money {
+	return Wrap(money.NewFromFloat(amount, code))
+}
+
+// Wrap returns the compat Money holding the same amount and currency as a decimal-backed Money.
+func Wrap(m *money.Money) *Money {
+	return &Money{m: *m}
+}
+
+// Unwrap returns the decimal-backed Money holding the same amount and currency, to call the decimal-native API.
+func (m *Money) Unwrap() *money.Money {
+	u := m.m
+	return &u
+}
+
+// AddCurrency lets you insert or update currency in currencies list. Like money.AddCurrency, it panics once
+// the money.DefaultRegistry is frozen.
+func AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int) *Currency {
+	return money.AddCurrency(code, Grapheme, Template, Decimal, Thousand, Fraction)
+}
+
+// GetCurrency returns the currency given the code.
+func GetCurrency(code string) *Currency {
+	return money.GetCurrency(code)
+}
+
+// NewFormatter creates new Formatter instance.
+func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *Formatter {
+	return money.NewFormatter(fraction, decimal, thousand, grapheme, template)
+}
+
+// Currency returns the currency used by Money.
+func (m *Money) Currency() *Currency {
+	return m.m.Currency()
+}
+
+// Amount returns a copy of the internal monetary value as an int64.
+// Any precision beyond the currency fraction, which only decimal-native operations produce, is truncated.
+func (m *Money) Amount() int64 {
+	return m.m.Amount()
+}
+
+// SameCurrency check if given Money is equals by currency.
+func (m *Money) SameCurrency(om *Money) bool {
+	return m.m.SameCurrency(&om.m)
+}
+
+// Equals checks equality between two Money types.
+func (m *Money) Equals(om *Money) (bool, error) {
+	return m.m.Equals(&om.m)
+}
+
+// GreaterThan checks whether the value of Money is greater than the other.
+func (m *Money) GreaterThan(om *Money) (bool, error) {
+	return m.m.GreaterThan(&om.m)
+}
+
+// GreaterThanOrEqual checks whether the value of Money is greater or equal than the other.
+func (m *Money) GreaterThanOrEqual(om *Money) (bool, error) {
+	return m.m.GreaterThanOrEqual(&om.m)
+}
+
+// LessThan checks whether the value of Money is less than the other.
+func (m *Money) LessThan(om *Money) (bool, error) {
+	return m.m.LessThan(&om.m)
+}
+
+// LessThanOrEqual checks whether the value of Money is less or equal than the other.
+func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
+	return m.m.LessThanOrEqual(&om.m)
+}
+
+// IsZero returns boolean of whether the value of Money is equals to zero.
+func (m *Money) IsZero() bool {
+	return m.m.IsZero()
+}
+
+// IsPositive returns boolean of whether the value of Money is positive.
+func (m *Money) IsPositive() bool {
+	return m.m.IsPositive()
+}
+
+// IsNegative returns boolean of whether the value of Money is negative.
+func (m *Money) IsNegative() bool {
+	return m.m.IsNegative()
+}
+
+// Absolute returns new Money struct from given Money using absolute monetary value.
+func (m *Money) Absolute() *Money {
+	return Wrap(m.m.Absolute())
+}
+
+// Negative returns new Money struct from given Money using negative monetary value.
+func (m *Money) Negative() *Money {
+	return Wrap(m.m.Negative())
+}
+
+// Add returns new Money struct with value representing sum of Self and Other Money.
+func (m *Money) Add(ms ...*Money) (*Money, error) {
+	r, err := m.m.Add(unwrapAll(ms)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Wrap(r), nil
+}
+
+// Subtract returns new Money struct with value representing difference of Self and Other Money.
+func (m *Money) Subtract(ms ...*Money) (*Money, error) {
+	r, err := m.m.Subtract(unwrapAll(ms)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Wrap(r), nil
+}
+
+// Multiply returns new Money struct with value representing Self multiplied value by multiplier.
+func (m *Money) Multiply(muls ...int64) *Money {
+	return Wrap(m.m.Multiply(muls...))
+}
+
+// Round returns new Money struct with value rounded to nearest zero.
+func (m *Money) Round() *Money {
+	return Wrap(m.m.Round())
+}
+
+// Split returns slice of Money structs with split Self value in given number.
+// After division leftover pennies will be distributed round-robin amongst the parties.
+// This means that parties listed first will likely receive more pennies than ones that are listed later.
+func (m *Money) Split(n int) ([]*Money, error) {
+	ms, err := m.m.Split(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapAll(ms), nil
+}
+
+// Allocate returns slice of Money structs with split Self value in given ratios.
+// It lets split money by given ratios without losing pennies and as Split operations distributes
+// leftover pennies amongst the parties with round-robin principle.
+func (m *Money) Allocate(rs ...int) ([]*Money, error) {
+	ms, err := m.m.Allocate(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapAll(ms), nil
+}
+
+// Display lets represent Money struct as string in given Currency value.
+func (m *Money) Display() string {
+	return m.m.Display()
+}
+
+// AsMajorUnits lets represent Money struct as subunits (float64) in given Currency value
+func (m *Money) AsMajorUnits() float64 {
+	return m.m.AsMajorUnits()
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (m *Money) UnmarshalJSON(b []byte) error {
+	return UnmarshalJSON(m, b)
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (m Money) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(m)
+}
+
+// Compare function compares two money of the same type
+//
+//	if m.amount > om.amount returns (1, nil)
+//	if m.amount == om.amount returns (0, nil
+//	if m.amount < om.amount returns (-1, nil)
+//
+// If compare moneys from distinct currency, return (m.amount, ErrCurrencyMismatch)
+func (m *Money) Compare(om *Money) (int, error) {
+	return m.m.Compare(&om.m)
+}
+
+// Value implements driver.Valuer to serialise a Money instance into a delimited string using the
+// DBMoneyValueSeparator, for example: "amount|currency_code"
+func (m *Money) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d%s%s", m.Amount(), DBMoneyValueSeparator, m.Currency().Code), nil
+}
+
+// Scan implements sql.Scanner to deserialize a Money instance from a DBMoneyValueSeparator-separated string,
+// for example: "amount|currency_code"
+func (m *Money) Scan(src interface{}) error {
+	// let's support string and []byte
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
+
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("don't know how to scan %T into Money; update your query to return a compat.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)
+	}
+
+	parts := strings.Split(s, DBMoneyValueSeparator)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("%#v is not valid to scan into Money; update your query to return a compat.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", s, DBMoneyValueSeparator)
+	}
+
+	amount, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("scanning %#v into an Amount: %v", parts[0], err)
+	}
+
+	var c Currency
+	if err := c.Scan(parts[1]); err != nil {
+		return fmt.Errorf("scanning %#v into a Currency: %v", parts[1], err)
+	}
+
+	*m = *New(amount, c.Code)
+	return nil
+}
+
+func unwrapAll(ms []*Money) []*money.Money {
+	us := make([]*money.Money, len(ms))
+	for i, m := range ms {
+		us[i] = &m.m
+	}
+
+	return us
+}
+
+func wrapAll(ms []*money.Money) []*Money {
+	ws := make([]*Money, len(ms))
+	for i, m := range ms {
+		ws[i] = Wrap(m)
+	}
+
+	return ws
+}
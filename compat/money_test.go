@@ -0,0 +1,184 @@
+package compat
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	money "github.com/noho-digital/go-money"
+	"github.com/shopspring/decimal"
+)
+
+// upstream lists the method set of the upstream Money, so a change to any signature fails to compile.
+type upstream interface {
+	Currency() *Currency
+	Amount() int64
+	SameCurrency(om *Money) bool
+	Equals(om *Money) (bool, error)
+	GreaterThan(om *Money) (bool, error)
+	GreaterThanOrEqual(om *Money) (bool, error)
+	LessThan(om *Money) (bool, error)
+	LessThanOrEqual(om *Money) (bool, error)
+	IsZero() bool
+	IsPositive() bool
+	IsNegative() bool
+	Absolute() *Money
+	Negative() *Money
+	Add(ms ...*Money) (*Money, error)
+	Subtract(ms ...*Money) (*Money, error)
+	Multiply(muls ...int64) *Money
+	Round() *Money
+	Split(n int) ([]*Money, error)
+	Allocate(rs ...int) ([]*Money, error)
+	Display() string
+	AsMajorUnits() float64
+	UnmarshalJSON(b []byte) error
+	MarshalJSON() ([]byte, error)
+	Compare(om *Money) (int, error)
+}
+
+var (
+	_ upstream = &Money{}
+
+	_ func(int64, string) *Money                                  = New
+	_ func(float64, string) *Money                                = NewFromFloat
+	_ func(string, string, string, string, string, int) *Currency = AddCurrency
+	_ func(string) *Currency                                      = GetCurrency
+	_ func(int, string, string, string, string) *Formatter        = NewFormatter
+	_ func(*Money, []byte) error                                  = UnmarshalJSON
+	_ func(Money) ([]byte, error)                                 = MarshalJSON
+	_ Amount                                                      = int64(0)
+)
+
+func TestMoney_Arithmetic(t *testing.T) {
+	m := New(1050, EUR)
+
+	r, err := m.Add(New(250, EUR), New(100, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Amount() != 1400 {
+		t.Errorf("Expected %d got %d", 1400, r.Amount())
+	}
+
+	r, err = m.Subtract(New(50, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Amount() != 1000 {
+		t.Errorf("Expected %d got %d", 1000, r.Amount())
+	}
+
+	if _, err := m.Add(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if r := m.Multiply(3); r.Amount() != 3150 {
+		t.Errorf("Expected %d got %d", 3150, r.Amount())
+	}
+
+	if r := m.Round(); r.Amount() != 1100 {
+		t.Errorf("Expected %d got %d", 1100, r.Amount())
+	}
+
+	if r := m.Negative().Absolute(); r.Amount() != 1050 {
+		t.Errorf("Expected %d got %d", 1050, r.Amount())
+	}
+
+	if c, err := m.Compare(New(1049, EUR)); err != nil || c != 1 {
+		t.Errorf("Expected (1, nil) got (%d, %v)", c, err)
+	}
+
+	if d := m.Display(); d != "€10.50" {
+		t.Errorf("Expected %s got %s", "€10.50", d)
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	ms, err := New(100, USD).Allocate(1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int64{34, 33, 33}
+	for i, m := range ms {
+		if m.Amount() != expected[i] {
+			t.Errorf("Expected %d got %d", expected[i], m.Amount())
+		}
+	}
+
+	ms, err = New(5, USD).Split(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms[0].Amount() != 3 || ms[1].Amount() != 2 {
+		t.Errorf("Expected [3 2] got [%d %d]", ms[0].Amount(), ms[1].Amount())
+	}
+}
+
+func TestWrap(t *testing.T) {
+	m := money.NewFromDecimal(decimal.RequireFromString("3.199"), USD)
+
+	w := Wrap(m)
+	if w.Amount() != 319 {
+		t.Errorf("Expected %d got %d", 319, w.Amount())
+	}
+
+	u := w.Unwrap()
+	if !u.Decimal().Equal(m.Decimal()) {
+		t.Errorf("Expected %s got %s", m.Decimal(), u.Decimal())
+	}
+
+	// Unwrap returns a copy, so the decimal-native API can't change the compat Money.
+	*u = *money.New(1, EUR)
+	if w.Currency().Code != USD {
+		t.Errorf("Expected %s got %s", USD, w.Currency().Code)
+	}
+}
+
+func TestMoney_JSON(t *testing.T) {
+	b, err := json.Marshal(New(1234, GBP))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ub, err := json.Marshal(money.New(1234, GBP))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != string(ub) {
+		t.Errorf("Expected %s got %s", ub, b)
+	}
+
+	var m Money
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Amount() != 1234 || m.Currency().Code != GBP {
+		t.Errorf("Expected 1234 GBP got %d %s", m.Amount(), m.Currency().Code)
+	}
+}
+
+func TestMoney_DB(t *testing.T) {
+	v, err := New(-42, JPY).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "-42|JPY" {
+		t.Errorf("Expected %s got %v", "-42|JPY", v)
+	}
+
+	var m Money
+	if err := m.Scan([]byte("-42|JPY")); err != nil {
+		t.Fatal(err)
+	}
+	if m.Amount() != -42 || m.Currency() != GetCurrency(JPY) {
+		t.Errorf("Expected -42 JPY got %d %s", m.Amount(), m.Currency().Code)
+	}
+
+	for _, src := range []interface{}{"42", "x|JPY", 42} {
+		if err := m.Scan(src); err == nil {
+			t.Errorf("Expected error scanning %#v", src)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package money
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoDefaultCurrency happens when a context-aware constructor gets a context without a default currency.
+var ErrNoDefaultCurrency = newError("money.no_default_currency", "no default currency in context")
+
+// defaultCurrencyKey is the context key of the default currency.
+type defaultCurrencyKey struct{}
+
+// WithDefaultCurrency returns a copy of ctx carrying the code of a default currency, such as the settlement
+// currency of the merchant of a request, for the context-aware constructors NewCtx, NewFromDecimalCtx
+// and NewFromStringCtx.
+func WithDefaultCurrency(ctx context.Context, code string) context.Context {
+	return context.WithValue(ctx, defaultCurrencyKey{}, code)
+}
+
+// DefaultCurrencyFromContext returns the code of the default currency carried by ctx, if any.
+func DefaultCurrencyFromContext(ctx context.Context) (string, bool) {
+	code, ok := ctx.Value(defaultCurrencyKey{}).(string)
+	return code, ok && code != ""
+}
+
+// NewCtx creates and returns new instance of Money in the default currency of ctx, see WithDefaultCurrency.
+// The currency is looked up in the registry of the tenant of ctx. It returns ErrNoDefaultCurrency when ctx
+// carries no default currency.
+func NewCtx(ctx context.Context, amount int64) (*Money, error) {
+	code, err := defaultCurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return TenantFromContext(ctx).New(amount, code), nil
+}
+
+// NewFromDecimalCtx creates and returns new instance of Money from a decimal amount of major units in the default
+// currency of ctx, see NewFromDecimal and NewCtx.
+func NewFromDecimalCtx(ctx context.Context, amount decimal.Decimal) (*Money, error) {
+	code, err := defaultCurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return TenantFromContext(ctx).registry().NewFromDecimal(amount, code), nil
+}
+
+// NewFromStringCtx creates and returns new instance of Money from a string holding an exact amount of major units
+// in the default currency of ctx, see NewFromString and NewCtx.
+func NewFromStringCtx(ctx context.Context, s string, mode ...RoundingMode) (*Money, error) {
+	code, err := defaultCurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return TenantFromContext(ctx).registry().NewFromString(s, code, mode...)
+}
+
+func defaultCurrency(ctx context.Context) (string, error) {
+	code, ok := DefaultCurrencyFromContext(ctx)
+	if !ok {
+		return "", ErrNoDefaultCurrency
+	}
+
+	return code, nil
+}
@@ -0,0 +1,58 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestWithDefaultCurrency(t *testing.T) {
+	if _, err := NewFromStringCtx(context.Background(), "12.34"); !errors.Is(err, ErrNoDefaultCurrency) {
+		t.Errorf("Expected ErrNoDefaultCurrency got %v", err)
+	}
+	if _, ok := DefaultCurrencyFromContext(WithDefaultCurrency(context.Background(), "")); ok {
+		t.Error("Expected an empty code not to be a default currency")
+	}
+
+	ctx := WithDefaultCurrency(context.Background(), EUR)
+	if code, ok := DefaultCurrencyFromContext(ctx); !ok || code != EUR {
+		t.Errorf("Expected %s got %s", EUR, code)
+	}
+
+	m, err := NewFromStringCtx(ctx, "12.34")
+	if err != nil || m.Amount() != 1234 || m.Currency().Code != EUR {
+		t.Errorf("Expected €12.34 got %v, %v", m, err)
+	}
+
+	if _, err := NewFromStringCtx(ctx, "12.345"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount got %v", err)
+	}
+	if m, _ := NewFromStringCtx(ctx, "12.345", RoundHalfEven); m.Amount() != 1234 {
+		t.Errorf("Expected %d got %d", 1234, m.Amount())
+	}
+
+	if m, _ := NewCtx(ctx, 500); m.Display() != "€5.00" {
+		t.Errorf("Expected %s got %s", "€5.00", m.Display())
+	}
+	if m, _ := NewFromDecimalCtx(ctx, decimal.RequireFromString("1.5")); m.Amount() != 150 {
+		t.Errorf("Expected %d got %d", 150, m.Amount())
+	}
+}
+
+func TestWithDefaultCurrency_Tenant(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithDefaultCurrency(WithTenant(context.Background(), &Tenant{Registry: r}), "PTS")
+	m, err := NewFromStringCtx(ctx, "1500")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Currency() != r.GetCurrency("PTS") || m.Amount() != 1500 {
+		t.Errorf("Expected 1,500 pts from the tenant registry got %v", m.Display())
+	}
+}
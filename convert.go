@@ -0,0 +1,75 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrRateNotFound happens when a RateProvider has no exchange rate for the requested currency pair.
+	ErrRateNotFound = newError("money.rate_not_found", "exchange rate not found")
+
+	// ErrInvalidRate happens when an exchange rate is zero or negative.
+	ErrInvalidRate = newError("money.invalid_rate", "exchange rate must be positive")
+)
+
+// RateProvider provides exchange rates between currencies.
+type RateProvider interface {
+	// Rate returns how many major units of currency to one major unit of currency from is worth.
+	Rate(from, to string) (decimal.Decimal, error)
+}
+
+// StaticRateProvider is a RateProvider backed by a fixed in-memory table of rates, keyed by
+// source and then target currency code.
+type StaticRateProvider map[string]map[string]decimal.Decimal
+
+// Add updates the rate table by setting the rate from one currency to another.
+func (p StaticRateProvider) Add(from, to string, rate decimal.Decimal) StaticRateProvider {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if p[from] == nil {
+		p[from] = make(map[string]decimal.Decimal)
+	}
+	p[from][to] = rate
+	return p
+}
+
+// Rate implements RateProvider. Converting a currency to itself always has a rate of one;
+// other pairs must have been added explicitly, inverse rates are not derived.
+func (p StaticRateProvider) Rate(from, to string) (decimal.Decimal, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, ok := p[from][to]
+	if !ok {
+		return decimal.Zero, fmt.Errorf("%w: %s to %s", ErrRateNotFound, from, to)
+	}
+
+	return rate, nil
+}
+
+// Convert returns new Money struct with the value of Self expressed in the given currency, using the
+// exchange rate returned by provider. The result is rounded to the minor unit of the target currency
+// using the given rounding mode, half away from zero by default.
+func (m *Money) Convert(to string, provider RateProvider, mode ...RoundingMode) (*Money, error) {
+	c := newCurrency(to).get()
+	if m.currency.equals(c) {
+		return &Money{amount: m.amount, currency: m.currency}, nil
+	}
+
+	rate, err := provider.Rate(m.currency.Code, c.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if !rate.IsPositive() {
+		return nil, fmt.Errorf("%w: %s to %s is %s", ErrInvalidRate, m.currency.Code, c.Code, rate)
+	}
+
+	amount := mutate.calc.multiplyDecimal(m.amount, rate).Shift(int32(c.Fraction - m.currency.Fraction))
+
+	return &Money{amount: mutate.calc.round(amount, 0, roundingMode(mode)), currency: c}, nil
+}
@@ -0,0 +1,56 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_Convert(t *testing.T) {
+	rates := StaticRateProvider{}.
+		Add(EUR, USD, decimal.RequireFromString("1.0837")).
+		Add(USD, JPY, decimal.RequireFromString("151.37")).
+		Add(JPY, BHD, decimal.RequireFromString("0.00249"))
+
+	tcs := []struct {
+		amount   int64
+		from     string
+		to       string
+		expected int64
+	}{
+		{10000, EUR, USD, 10837},
+		{1, EUR, USD, 1},
+		{-10000, EUR, USD, -10837},
+		{12345, USD, JPY, 18687},
+		{1000, JPY, BHD, 2490},
+		{12345, EUR, EUR, 12345},
+		{0, EUR, USD, 0},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, tc.from).Convert(tc.to, rates)
+		if err != nil {
+			t.Errorf("Unexpected error converting %d %s to %s: %v", tc.amount, tc.from, tc.to, err)
+			continue
+		}
+
+		if r.Amount() != tc.expected || r.Currency().Code != tc.to {
+			t.Errorf("Expected %d %s to convert to %d %s got %d %s", tc.amount, tc.from, tc.expected, tc.to, r.Amount(), r.Currency().Code)
+		}
+	}
+}
+
+func TestMoney_ConvertErrors(t *testing.T) {
+	rates := StaticRateProvider{}.Add("eur", "gbp", decimal.Zero)
+
+	_, err := New(100, USD).Convert(EUR, rates)
+	if !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Expected ErrRateNotFound got %v", err)
+	}
+
+	_, err = New(100, EUR).Convert(GBP, rates)
+	if !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("Expected ErrInvalidRate got %v", err)
+	}
+}
@@ -19,6 +19,10 @@ type Currencies map[string]*Currency
 
 // CurrencyByNumericCode returns the currency given the numeric code defined in ISO-4271.
 func (c Currencies) CurrencyByNumericCode(code string) *Currency {
+	if code == "" {
+		return nil
+	}
+
 	for _, sc := range c {
 		if sc.NumericCode == code {
 			return sc
@@ -44,13 +48,13 @@ func (c Currencies) Add(currency *Currency) Currencies {
 	return c
 }
 
-// currencies represents a collection of currency.
+// currencies represents a collection of currency, owned by the DefaultRegistry.
 var currencies = Currencies{
 	AED: {Decimal: ".", Thousand: ",", Code: AED, Fraction: 2, NumericCode: "784", Grapheme: ".\u062f.\u0625", Template: "1 $"},
 	AFN: {Decimal: ".", Thousand: ",", Code: AFN, Fraction: 2, NumericCode: "971", Grapheme: "\u060b", Template: "1 $"},
 	ALL: {Decimal: ".", Thousand: ",", Code: ALL, Fraction: 2, NumericCode: "008", Grapheme: "L", Template: "$1"},
 	AMD: {Decimal: ".", Thousand: ",", Code: AMD, Fraction: 2, NumericCode: "051", Grapheme: "\u0564\u0580.", Template: "1 $"},
-	ANG: {Decimal: ",", Thousand: ".", Code: ANG, Fraction: 2, NumericCode: "532", Grapheme: "\u0192", Template: "$1"},
+	ANG: {Decimal: ",", Thousand: ".", Code: ANG, Fraction: 2, NumericCode: "", Grapheme: "\u0192", Template: "$1"},
 	AOA: {Decimal: ".", Thousand: ",", Code: AOA, Fraction: 2, NumericCode: "973", Grapheme: "Kz", Template: "1$"},
 	ARS: {Decimal: ",", Thousand: ".", Code: ARS, Fraction: 2, NumericCode: "032", Grapheme: "$", Template: "$1"},
 	AUD: {Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "A$", Template: "$1"},
@@ -225,18 +229,35 @@ var currencies = Currencies{
 	ZWL: {Decimal: ".", Thousand: ",", Code: ZWL, Fraction: 2, NumericCode: "932", Grapheme: "Z$", Template: "$1"},
 }
 
-// AddCurrency lets you insert or update currency in currencies list.
-func AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int) *Currency {
+// AddCurrency lets you insert or update currency in currencies list of the DefaultRegistry.
+// The ISO 4217 numeric code is optional, set it to make the currency available to GetCurrencyByNumericCode.
+// It panics with an error wrapping ErrRegistryFrozen once the DefaultRegistry is frozen, so a stray registration
+// can't go unnoticed; use DefaultRegistry.AddCurrency to get the error instead.
+func AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int, NumericCode ...string) *Currency {
 	c := Currency{
-		Code:     code,
-		Grapheme: Grapheme,
-		Template: Template,
-		Decimal:  Decimal,
-		Thousand: Thousand,
-		Fraction: Fraction,
+		Code:        code,
+		NumericCode: numericCode(NumericCode),
+		Grapheme:    Grapheme,
+		Template:    Template,
+		Decimal:     Decimal,
+		Thousand:    Thousand,
+		Fraction:    Fraction,
+	}
+	added, err := DefaultRegistry.add(&c)
+	if err != nil {
+		panic(err)
 	}
-	currencies.Add(&c)
-	return &c
+
+	return added
+}
+
+// numericCode returns the first of the optional numeric codes, or an empty string when none is given.
+func numericCode(codes []string) string {
+	if len(codes) == 0 {
+		return ""
+	}
+
+	return codes[0]
 }
 
 func newCurrency(code string) *Currency {
@@ -245,14 +266,19 @@ func newCurrency(code string) *Currency {
 
 // GetCurrency returns the currency given the code.
 func GetCurrency(code string) *Currency {
-	return currencies.CurrencyByCode(strings.ToUpper(code))
+	return DefaultRegistry.GetCurrency(code)
 }
 
 // GetCurrencyByNumericCode returns the currency given the numeric code.
 // The code parameter should be a string representing a 3-digit numeric code
 // as defined in the ISO-4217 standard. For example, "840" for USD or "978" for EUR.
 func GetCurrencyByNumericCode(code string) *Currency {
-	return currencies.CurrencyByNumericCode(code)
+	return DefaultRegistry.GetCurrencyByNumericCode(code)
+}
+
+// AllCurrencies returns the currencies registered in the DefaultRegistry, ordered by code.
+func AllCurrencies() []*Currency {
+	return DefaultRegistry.Currencies()
 }
 
 // Formatter returns currency formatter representing
@@ -273,13 +299,9 @@ func (c *Currency) getDefault() *Currency {
 	return &Currency{Decimal: ".", Thousand: ",", Code: c.Code, Fraction: 2, Grapheme: c.Code, Template: "1$"}
 }
 
-// get extended currency using currencies list.
+// get extended currency using the currencies list of the DefaultRegistry.
 func (c *Currency) get() *Currency {
-	if curr, ok := currencies[c.Code]; ok {
-		return curr
-	}
-
-	return c.getDefault()
+	return DefaultRegistry.get(c.Code)
 }
 
 func (c *Currency) equals(oc *Currency) bool {
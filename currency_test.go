@@ -1,6 +1,7 @@
 package money
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -154,3 +155,79 @@ func TestCurrency_GetCurrencyByNumericCodeNonExistingCurrency(t *testing.T) {
 		t.Errorf("Unexpected currency returned %+v", currency)
 	}
 }
+
+func TestCurrency_NumericCodesUnique(t *testing.T) {
+	seen := make(map[string]string)
+	for _, c := range AllCurrencies() {
+		if c.NumericCode == "" {
+			continue
+		}
+
+		if code, ok := seen[c.NumericCode]; ok {
+			t.Errorf("Numeric code %s is used by both %s and %s", c.NumericCode, code, c.Code)
+		}
+		seen[c.NumericCode] = c.Code
+	}
+
+	if GetCurrencyByNumericCode("") != nil {
+		t.Error("Expected no currency for an empty numeric code")
+	}
+
+	// 532 moved from ANG to its successor XCG
+	if c := GetCurrencyByNumericCode("532"); c == nil || c.Code != XCG {
+		t.Errorf("Expected %s got %v", XCG, c)
+	}
+
+	if c := GetCurrency(ANG); c == nil || c.NumericCode != "" {
+		t.Errorf("Expected %s without numeric code got %v", ANG, c)
+	}
+}
+
+func TestCurrency_AddCurrencyNumericCode(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0, "999"); err != nil {
+		t.Fatal(err)
+	}
+
+	c := r.GetCurrencyByNumericCode("999")
+	if c == nil || c.Code != "PTS" {
+		t.Fatalf("Expected %s got %v", "PTS", c)
+	}
+
+	m, err := r.NewFromNumericCode(1500, "999")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "1,500 pts" {
+		t.Errorf("Expected %s got %s", "1,500 pts", m.Display())
+	}
+}
+
+func TestNewFromNumericCode(t *testing.T) {
+	m, err := NewFromNumericCode(1234, "840")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Currency().Code != USD || m.Amount() != 1234 {
+		t.Errorf("Expected %d %s got %d %s", 1234, USD, m.Amount(), m.Currency().Code)
+	}
+
+	if _, err := NewFromNumericCode(1234, "000"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+}
+
+func TestAllCurrencies(t *testing.T) {
+	cs := AllCurrencies()
+	if len(cs) < len(builtinCurrencies) {
+		t.Errorf("Expected at least %d currencies got %d", len(builtinCurrencies), len(cs))
+	}
+
+	for i := 1; i < len(cs); i++ {
+		if cs[i-1].Code >= cs[i].Code {
+			t.Errorf("Expected currencies ordered by code, got %s before %s", cs[i-1].Code, cs[i].Code)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package money
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidCursor happens when a cursor or sortable key can't be decoded into Money.
+var ErrInvalidCursor = newError("money.invalid_cursor", "invalid cursor")
+
+// Sign bytes of the cursor encoding, ordered like the amounts they introduce.
+const (
+	cursorNegative byte = 1 + iota
+	cursorZero
+	cursorPositive
+)
+
+// Cursor returns an opaque, URL-safe encoding of Money for keyset pagination, such as
+// "038000000631323334353600-EUR" for €1,234.56. Cursors compare as strings in the same order as their amounts,
+// ties broken by currency code, so a list sorted by amount can be resumed with a plain "amount > cursor" condition.
+// Amounts with precision beyond the currency fraction are encoded exactly.
+func (m *Money) Cursor() string {
+	return hex.EncodeToString(encodeOrdered(m.amount)) + "-" + m.currency.Code
+}
+
+// ParseCursor decodes a cursor returned by Cursor back into Money.
+func ParseCursor(s string) (*Money, error) {
+	i := strings.Index(s, "-")
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+
+	b, err := hex.DecodeString(s[:i])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+
+	amount, n, err := decodeOrdered(b)
+	if err != nil || n != len(b) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+
+	c := GetCurrency(s[i+1:])
+	if c == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCurrency, s)
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// SortableBytes returns a binary encoding of Money whose byte-wise lexicographic order is the numeric order of
+// the amounts, negative ones included, ties broken by currency code. Use it as key in ordered key-value stores,
+// such as LevelDB, BoltDB or DynamoDB sort keys, to run range queries over amounts.
+// Amounts with precision beyond the currency fraction are encoded exactly.
+func (m *Money) SortableBytes() []byte {
+	return append(encodeOrdered(m.amount), m.currency.Code...)
+}
+
+// NewFromSortableBytes decodes a key returned by SortableBytes back into Money.
+func NewFromSortableBytes(b []byte) (*Money, error) {
+	amount, n, err := decodeOrdered(b)
+	if err != nil {
+		return nil, err
+	}
+
+	c := GetCurrency(string(b[n:]))
+	if c == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCurrency, b[n:])
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// encodeOrdered encodes a decimal so that the byte-wise order of encodings is the numeric order of the values.
+// A value 0.D × 10^E, with D its significant digits, is written as a sign byte, E as a big-endian int32 with
+// its sign bit flipped, the ASCII digits of D and a zero terminator. Negative values have all bytes after
+// the sign complemented, which reverses their order.
+func encodeOrdered(d decimal.Decimal) []byte {
+	if d.IsZero() {
+		return []byte{cursorZero}
+	}
+
+	digits := new(big.Int).Abs(d.Coefficient()).String()
+	trimmed := strings.TrimRight(digits, "0")
+	e := int32(len(trimmed)) + d.Exponent() + int32(len(digits)-len(trimmed))
+
+	b := make([]byte, 5, 6+len(trimmed))
+	b[0] = cursorPositive
+	binary.BigEndian.PutUint32(b[1:], uint32(e)^1<<31)
+	b = append(b, trimmed...)
+	b = append(b, 0)
+
+	if d.IsNegative() {
+		b[0] = cursorNegative
+		for i := 1; i < len(b); i++ {
+			b[i] = ^b[i]
+		}
+	}
+
+	return b
+}
+
+// decodeOrdered decodes a decimal encoded by encodeOrdered at the start of b and returns the number of bytes read.
+func decodeOrdered(b []byte) (decimal.Decimal, int, error) {
+	if len(b) > 0 && b[0] == cursorZero {
+		return decimal.Zero, 1, nil
+	}
+
+	if len(b) < 7 || (b[0] != cursorNegative && b[0] != cursorPositive) {
+		return decimal.Decimal{}, 0, ErrInvalidCursor
+	}
+
+	neg := b[0] == cursorNegative
+	var flip byte
+	if neg {
+		flip = 0xff
+	}
+
+	end := 5
+	for end < len(b) && b[end] != flip {
+		end++
+	}
+	if end == len(b) {
+		return decimal.Decimal{}, 0, ErrInvalidCursor
+	}
+
+	body := make([]byte, end-1)
+	for i := range body {
+		body[i] = b[i+1] ^ flip
+	}
+
+	e := int32(binary.BigEndian.Uint32(body) ^ 1<<31)
+	digits := string(body[4:])
+	if digits == "" || !isDigits(digits) || digits[0] == '0' || digits[len(digits)-1] == '0' {
+		return decimal.Decimal{}, 0, ErrInvalidCursor
+	}
+
+	coef, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		coef.Neg(coef)
+	}
+
+	return decimal.NewFromBigInt(coef, e-int32(len(digits))), end + 1, nil
+}
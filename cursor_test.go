@@ -0,0 +1,117 @@
+package money
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_CursorOrder(t *testing.T) {
+	values := []string{
+		"-92233720368547758070", "-1000", "-999.5", "-120", "-12.3", "-12", "-1.2", "-1", "-0.5", "-0.05",
+		"0", "0.05", "0.5", "1", "1.2", "9", "10", "12", "12.3", "100", "120", "999.5", "1000", "1000.01",
+		"9223372036854775807", "92233720368547758070",
+	}
+
+	cursors := make([]string, len(values))
+	for i, v := range values {
+		m := &Money{amount: decimal.RequireFromString(v), currency: GetCurrency(EUR)}
+		cursors[i] = m.Cursor()
+	}
+
+	if !sort.StringsAreSorted(cursors) {
+		for i := 1; i < len(cursors); i++ {
+			if cursors[i-1] >= cursors[i] {
+				t.Errorf("Expected cursor of %s (%s) to sort before %s (%s)", values[i-1], cursors[i-1], values[i], cursors[i])
+			}
+		}
+	}
+}
+
+func TestMoney_CursorRoundTrip(t *testing.T) {
+	ms := []*Money{
+		New(123456, EUR),
+		New(-1, USD),
+		New(0, JPY),
+		New(1200, GBP),
+		New(math.MinInt64, EUR),
+		NewFromDecimal(decimal.RequireFromString("1.3459"), USD),
+	}
+
+	for _, m := range ms {
+		got, err := ParseCursor(m.Cursor())
+		if err != nil {
+			t.Errorf("Unexpected error parsing cursor %s: %v", m.Cursor(), err)
+			continue
+		}
+
+		if !got.amount.Equal(m.amount) || got.Currency().Code != m.Currency().Code {
+			t.Errorf("Expected %s %s got %s %s", m.amount, m.Currency().Code, got.amount, got.Currency().Code)
+		}
+	}
+
+	if c := New(123456, EUR).Cursor(); c != "038000000631323334353600-EUR" {
+		t.Errorf("Expected %s got %s", "038000000631323334353600-EUR", c)
+	}
+}
+
+func TestMoney_SortableBytes(t *testing.T) {
+	values := []int64{math.MinInt64, -100000, -1001, -1000, -999, -10, -1, 0, 1, 9, 10, 11, 100, 1000, 1001, math.MaxInt64}
+
+	keys := make([][]byte, len(values))
+	for i, v := range values {
+		keys[i] = New(v, USD).SortableBytes()
+
+		m, err := NewFromSortableBytes(keys[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.Amount() != v || m.Currency().Code != USD {
+			t.Errorf("Expected %d %s got %d %s", v, USD, m.Amount(), m.Currency().Code)
+		}
+	}
+
+	for i := 1; i < len(keys); i++ {
+		if bytes.Compare(keys[i-1], keys[i]) >= 0 {
+			t.Errorf("Expected key of %d %x to sort before %d %x", values[i-1], keys[i-1], values[i], keys[i])
+		}
+	}
+
+	if bytes.Compare(New(5, EUR).SortableBytes(), New(5, USD).SortableBytes()) >= 0 {
+		t.Error("Expected equal amounts to sort by currency code")
+	}
+
+	if _, err := NewFromSortableBytes([]byte{cursorZero, 'X', 'X', 'X'}); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+
+	if _, err := NewFromSortableBytes([]byte{9}); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected %v got %v", ErrInvalidCursor, err)
+	}
+}
+
+func TestParseCursor_Errors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{"", ErrInvalidCursor},
+		{"02", ErrInvalidCursor},
+		{"zz-EUR", ErrInvalidCursor},
+		{"04-EUR", ErrInvalidCursor},
+		{"038000000631303000-EUR", ErrInvalidCursor},
+		{"038000000631323334353601-EUR", ErrInvalidCursor},
+		{"02-XXX", ErrUnknownCurrency},
+	}
+
+	for _, tc := range tcs {
+		if _, err := ParseCursor(tc.input); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %q to fail with %v got %v", tc.input, tc.err, err)
+		}
+	}
+}
@@ -9,7 +9,21 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// ErrInvalidDBValue happens when a database value can't be scanned into Money, an Amount or a Currency.
+var ErrInvalidDBValue = newError("money.invalid_db_value", "invalid database value")
+
+// Injection points for the database storage format.
+// The default stores Money as a DBMoneyValueSeparator-separated string; to use another format,
+// overwrite them like below.
+//
+//	money.DBValue = money.JSONDBValue
+//	money.DBScan = money.JSONDBScan
 var (
+	// DBValue is injection point of driver.Valuer for money.Money
+	DBValue = defaultDBValue
+	// DBScan is injection point of sql.Scanner for money.Money
+	DBScan = defaultDBScan
+
 	// DBMoneyValueSeparator is used to join together the Amount and Currency components of money.Money instances
 	// allowing them to be stored as strings (via the driver.Valuer interface) and unmarshalled as strings (via
 	// the sql.Scanner interface); set this value to use a different separator.
@@ -22,37 +36,54 @@ const (
 	DefaultDBMoneyValueSeparator = "|"
 )
 
-// Value implements driver.Valuer to serialise a Money instance into a delimited string using the DBMoneyValueSeparator
-// for example: "amount|currency_code"
+// Value implements driver.Valuer to serialise a Money instance using DBValue, by default into a delimited string
+// using the DBMoneyValueSeparator, for example: "amount|currency_code". The amount is in minor units, written
+// exactly, such as "319.9|USD" for Money carrying precision beyond the currency fraction.
 func (m *Money) Value() (driver.Value, error) {
-	return fmt.Sprintf("%d%s%s", m.amount.IntPart(), DBMoneyValueSeparator, m.Currency().Code), nil
+	return DBValue(*m)
 }
 
-// Scan implements sql.Scanner to deserialize a Money instance from a DBMoneyValueSeparator-separated string
-// for example: "amount|currency_code"
+// Scan implements sql.Scanner to deserialize a Money instance using DBScan, by default from a
+// DBMoneyValueSeparator-separated string, for example: "amount|currency_code"
 func (m *Money) Scan(src interface{}) error {
+	return DBScan(m, src)
+}
+
+func defaultDBValue(m Money) (driver.Value, error) {
+	return m.amount.String() + DBMoneyValueSeparator + m.Currency().Code, nil
+}
+
+func defaultDBScan(m *Money, src interface{}) error {
 	var amount Amount
-	currency := &Currency{}
+	var currency *Currency
+
+	// let's support string and []byte
+	if b, ok := src.([]byte); ok {
+		src = string(b)
+	}
 
-	// let's support string and int64
 	switch src := src.(type) {
 	case string:
 		parts := strings.Split(src, DBMoneyValueSeparator)
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-			return fmt.Errorf("%#v is not valid to scan into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)
+			return fmt.Errorf("%w: %#v is not valid to scan into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", ErrInvalidDBValue, src, DBMoneyValueSeparator)
 		}
 
 		if a, err := strconv.ParseInt(parts[0], 10, 64); err == nil {
 			amount = decimal.NewFromInt(a)
+		} else if d, derr := parseNumber(parts[0], numberFormat{decimal: "."}); derr == nil {
+			amount = d
 		} else {
-			return fmt.Errorf("scanning %#v into an Amount: %v", parts[0], err)
+			return fmt.Errorf("%w: scanning %#v into an Amount: %v", ErrInvalidDBValue, parts[0], err)
 		}
 
-		if err := currency.Scan(parts[1]); err != nil {
-			return fmt.Errorf("scanning %#v into a Currency: %v", parts[1], err)
+		c, err := scanCurrency(parts[1])
+		if err != nil {
+			return fmt.Errorf("scanning %#v into a Currency: %w", parts[1], err)
 		}
+		currency = c
 	default:
-		return fmt.Errorf("don't know how to scan %T into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", src, DBMoneyValueSeparator)
+		return fmt.Errorf("%w: don't know how to scan %T into Money; update your query to return a money.DBMoneyValueSeparator-separated pair of \"amount%scurrency_code\"", ErrInvalidDBValue, src, DBMoneyValueSeparator)
 	}
 
 	// allocate new Money with the scanned amount and currency
@@ -64,6 +95,91 @@ func (m *Money) Scan(src interface{}) error {
 	return nil
 }
 
+// JSONDBValue is a DBValue storing Money as its JSON encoding, for json and jsonb columns.
+func JSONDBValue(m Money) (driver.Value, error) {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// JSONDBScan is a DBScan reading Money from its JSON encoding, for json and jsonb columns.
+func JSONDBScan(m *Money, src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return m.UnmarshalJSON([]byte(src))
+	case []byte:
+		return m.UnmarshalJSON(src)
+	default:
+		return fmt.Errorf("%w: don't know how to scan %T into Money; update your query to return a JSON document", ErrInvalidDBValue, src)
+	}
+}
+
+// ScanColumns returns a pair of sql.Scanner destinations for Money stored as an integer amount of minor units
+// in one column and the currency code in another. Money is updated once both columns are scanned:
+//
+//	var m money.Money
+//	amount, currency := m.ScanColumns()
+//	err := row.Scan(&id, amount, currency)
+//
+// To store Money that way, use m.Amount() and m.Currency() as the column values.
+func (m *Money) ScanColumns() (amount, currency interface{}) {
+	c := &columnScanner{money: m}
+	return (*amountColumn)(c), (*currencyColumn)(c)
+}
+
+// columnScanner collects the amount and currency columns of a Money.
+type columnScanner struct {
+	money    *Money
+	amount   *Amount
+	currency *Currency
+}
+
+type amountColumn columnScanner
+
+// Scan implements sql.Scanner for the amount column.
+func (c *amountColumn) Scan(src interface{}) error {
+	var a int64
+	switch src := src.(type) {
+	case int64:
+		a = src
+	case string, []byte:
+		var err error
+		if a, err = strconv.ParseInt(fmt.Sprintf("%s", src), 10, 64); err != nil {
+			return fmt.Errorf("%w: scanning %#v into an Amount: %v", ErrInvalidDBValue, src, err)
+		}
+	default:
+		return fmt.Errorf("%w: don't know how to scan %T into an Amount; store the amount in minor units as an integer", ErrInvalidDBValue, src)
+	}
+
+	amount := decimal.NewFromInt(a)
+	c.amount = &amount
+	(*columnScanner)(c).update()
+	return nil
+}
+
+type currencyColumn columnScanner
+
+// Scan implements sql.Scanner for the currency column.
+func (c *currencyColumn) Scan(src interface{}) error {
+	currency, err := scanCurrency(src)
+	if err != nil {
+		return err
+	}
+
+	c.currency = currency
+	(*columnScanner)(c).update()
+	return nil
+}
+
+func (c *columnScanner) update() {
+	if c.amount != nil && c.currency != nil {
+		*c.money = Money{amount: *c.amount, currency: c.currency}
+	}
+}
+
 // Value implements driver.Valuer to serialize a Currency code into a string for saving to a database
 func (c Currency) Value() (driver.Value, error) {
 	return c.Code, nil
@@ -71,21 +187,34 @@ func (c Currency) Value() (driver.Value, error) {
 
 // Scan implements sql.Scanner to deserialize a Currency from a string value read from a database
 func (c *Currency) Scan(src interface{}) error {
+	val, err := scanCurrency(src)
+	if err != nil {
+		return err
+	}
+
+	// copy the value
+	*c = *val
+
+	return nil
+}
+
+// scanCurrency returns the shared Currency instance for a database value, so scanned Money doesn't
+// hold its own copy of the currency.
+func scanCurrency(src interface{}) (*Currency, error) {
 	var val *Currency
-	// let's support string only
+	// let's support string and []byte only
 	switch src := src.(type) {
 	case string:
 		val = GetCurrency(src)
+	case []byte:
+		val = GetCurrency(string(src))
 	default:
-		return fmt.Errorf("%T is not a supported type for a Currency (store the Currency.Code value as a string only)", src)
+		return nil, fmt.Errorf("%w: %T is not a supported type for a Currency (store the Currency.Code value as a string only)", ErrInvalidDBValue, src)
 	}
 
 	if val == nil {
-		return fmt.Errorf("GetCurrency(%#v) returned nil", src)
+		return nil, fmt.Errorf("%w: %#v", ErrUnknownCurrency, src)
 	}
 
-	// copy the value
-	*c = *val
-
-	return nil
+	return val, nil
 }
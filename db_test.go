@@ -1,10 +1,13 @@
 package money
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/shopspring/decimal"
 )
 
 func TestMoney_Value(t *testing.T) {
@@ -24,6 +27,11 @@ func TestMoney_Value(t *testing.T) {
 			separator: "+-+",
 			want:      "-10+-+USD",
 		},
+		{
+			have:      NewFromDecimal(decimal.RequireFromString("3.199"), USD),
+			separator: "|",
+			want:      "319.9|USD",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%#v", tt.have), func(t *testing.T) {
@@ -117,6 +125,22 @@ func TestMoney_Scan(t *testing.T) {
 	}
 }
 
+func TestMoney_ValueScanExact(t *testing.T) {
+	m := NewFromDecimal(decimal.RequireFromString("-3.199"), USD)
+	v, err := m.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got Money
+	if err := got.Scan(v); err != nil {
+		t.Fatal(err)
+	}
+	if !got.amount.Equal(m.amount) || got.Currency().Code != USD {
+		t.Errorf("Expected %s got %s", m.DebugString(), got.DebugString())
+	}
+}
+
 func TestCurrency_Value(t *testing.T) {
 	for code, cc := range currencies {
 		t.Run(code, func(t *testing.T) {
@@ -150,3 +174,87 @@ func TestCurrency_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestMoney_ScanBytes(t *testing.T) {
+	DBMoneyValueSeparator = DefaultDBMoneyValueSeparator
+
+	got := &Money{}
+	if err := got.Scan([]byte("10|CAD")); err != nil {
+		t.Fatal(err)
+	}
+
+	if eq, err := got.Equals(New(10, CAD)); err != nil || !eq {
+		t.Errorf("Scan() got = %s %s, want 10 CAD", got.Display(), got.Currency().Code)
+	}
+
+	if got.Currency() != GetCurrency(CAD) {
+		t.Error("expected scanned Money to share the registered Currency")
+	}
+}
+
+func TestMoney_JSONDBValueScan(t *testing.T) {
+	DBValue, DBScan = JSONDBValue, JSONDBScan
+	MarshalJSON, UnmarshalJSON = defaultMarshalJSON, defaultUnmarshalJSON
+	defer func() { DBValue, DBScan = defaultDBValue, defaultDBScan }()
+
+	v, err := New(-1050, EUR).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != `{"amount": -1050, "currency": "EUR"}` {
+		t.Errorf("Value() got = %v", v)
+	}
+
+	for _, src := range []interface{}{v, []byte(v.(string))} {
+		got := &Money{}
+		if err := got.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+
+		if eq, err := got.Equals(New(-1050, EUR)); err != nil || !eq {
+			t.Errorf("Scan() got = %s %s, want -1050 EUR", got.Display(), got.Currency().Code)
+		}
+	}
+
+	if err := (&Money{}).Scan(10); err == nil {
+		t.Error("Expected error scanning int64 as JSON")
+	}
+}
+
+func TestMoney_ScanColumns(t *testing.T) {
+	tests := []struct {
+		amount   interface{}
+		currency interface{}
+		want     *Money
+		wantErr  bool
+	}{
+		{amount: int64(1050), currency: "EUR", want: New(1050, EUR)},
+		{amount: []byte("-7"), currency: []byte("JPY"), want: New(-7, JPY)},
+		{amount: "12", currency: "USD", want: New(12, USD)},
+		{amount: "1.5", currency: "USD", wantErr: true},
+		{amount: 1.5, currency: "USD", wantErr: true},
+		{amount: int64(1), currency: 12, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %v", tt.amount, tt.currency), func(t *testing.T) {
+			got := &Money{}
+			amount, currency := got.ScanColumns()
+
+			err := amount.(sql.Scanner).Scan(tt.amount)
+			if err == nil {
+				err = currency.(sql.Scanner).Scan(tt.currency)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if eq, err := got.Equals(tt.want); err != nil || !eq {
+				t.Errorf("Scan() got = %s %s, want %s %s", got.Display(), got.Currency().Code, tt.want.Display(), tt.want.Currency().Code)
+			}
+		})
+	}
+}
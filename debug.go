@@ -0,0 +1,26 @@
+package money
+
+import "fmt"
+
+// DebugString returns the exact internal state of Money for diagnosing rounding disputes: its amount in major and
+// minor units, the coefficient and exponent the amount is stored as, and a snapshot of its currency metadata.
+// Strings are quoted, so invisible characters such as a no-break space are escaped. The format is meant for humans
+// and may change; don't parse it.
+//
+//	Money{major: 12.345, minor: 1234.5, coefficient: 12345, exponent: -1, currency: Currency{Code: "EUR", ...}}
+func (m *Money) DebugString() string {
+	if m == nil {
+		return "Money(nil)"
+	}
+
+	major := "<no currency>"
+	currency := "nil"
+	if c := m.currency; c != nil {
+		major = m.Decimal().String()
+		currency = fmt.Sprintf("Currency{Code: %q, NumericCode: %q, Fraction: %d, Grapheme: %q, Template: %q, Decimal: %q, Thousand: %q}",
+			c.Code, c.NumericCode, c.Fraction, c.Grapheme, c.Template, c.Decimal, c.Thousand)
+	}
+
+	return fmt.Sprintf("Money{major: %s, minor: %s, coefficient: %s, exponent: %d, currency: %s}",
+		major, m.amount.String(), m.amount.Coefficient(), m.amount.Exponent(), currency)
+}
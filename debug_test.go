@@ -0,0 +1,43 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_DebugString(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		expected string
+	}{
+		{
+			NewFromDecimal(decimal.RequireFromString("12.345"), EUR),
+			`Money{major: 12.345, minor: 1234.5, coefficient: 12345, exponent: -1, currency: Currency{Code: "EUR", NumericCode: "978", Fraction: 2, Grapheme: "€", Template: "$1", Decimal: ".", Thousand: ","}}`,
+		},
+		{
+			New(-100, JPY),
+			`Money{major: -100, minor: -100, coefficient: -100, exponent: 0, currency: Currency{Code: "JPY", NumericCode: "392", Fraction: 0, Grapheme: "¥", Template: "$1", Decimal: ".", Thousand: ","}}`,
+		},
+		{
+			&Money{},
+			`Money{major: <no currency>, minor: 0, coefficient: 0, exponent: 0, currency: nil}`,
+		},
+		{
+			nil,
+			`Money(nil)`,
+		},
+	}
+
+	for _, tc := range tcs {
+		if s := tc.money.DebugString(); s != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, s)
+		}
+	}
+
+	r := NewRegistry()
+	c, _ := r.AddCurrency("XNB", "x", "1\u00a0$", ".", ",", 2)
+	if s := r.New(1, c.Code).DebugString(); s != `Money{major: 0.01, minor: 1, coefficient: 1, exponent: 0, currency: Currency{Code: "XNB", NumericCode: "", Fraction: 2, Grapheme: "x", Template: "1\u00a0$", Decimal: ".", Thousand: ","}}` {
+		t.Errorf("Expected the no-break space to be escaped got %s", s)
+	}
+}
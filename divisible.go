@@ -0,0 +1,37 @@
+package money
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// DivisibleBy checks whether Money splits into n equal parts of whole minor units, so that Split(n) has no
+// leftover pennies to distribute. It is false for n zero and for Money carrying precision beyond the currency fraction.
+func (m *Money) DivisibleBy(n int64) bool {
+	if n == 0 || !m.amount.IsInteger() {
+		return false
+	}
+
+	return mutate.calc.modulus(m.amount, n).IsZero()
+}
+
+// DividesEvenly returns how many whole times other fits into Money, and whether it fits exactly with nothing left,
+// e.g. (3, true) for €1.50 and €0.50 but (2, false) for €1.20 and €0.50. It returns ErrCurrencyMismatch for
+// Money in another currency, ErrDivisionByZero when other is zero and ErrOverflow when the count doesn't fit an int64.
+func (m *Money) DividesEvenly(om *Money) (int64, bool, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return 0, false, err
+	}
+
+	if om.amount.IsZero() {
+		return 0, false, ErrDivisionByZero
+	}
+
+	q, r := mutate.calc.quoRem(m.amount, om.amount)
+	if q.GreaterThan(decimal.NewFromInt(math.MaxInt64)) || q.LessThan(decimal.NewFromInt(math.MinInt64)) {
+		return 0, false, ErrOverflow
+	}
+
+	return q.IntPart(), r.IsZero(), nil
+}
@@ -0,0 +1,70 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_DivisibleBy(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		n        int64
+		expected bool
+	}{
+		{100, 4, true},
+		{100, 3, false},
+		{-90, 3, true},
+		{90, -3, true},
+		{0, 7, true},
+		{100, 0, false},
+	}
+
+	for _, tc := range tcs {
+		if r := New(tc.amount, EUR).DivisibleBy(tc.n); r != tc.expected {
+			t.Errorf("Expected %d divisible by %d to be %t got %t", tc.amount, tc.n, tc.expected, r)
+		}
+	}
+
+	if NewFromDecimal(decimal.RequireFromString("1.005"), EUR).DivisibleBy(1) {
+		t.Error("Expected Money with sub-minor precision not to be divisible")
+	}
+}
+
+func TestMoney_DividesEvenly(t *testing.T) {
+	tcs := []struct {
+		amount, other int64
+		count         int64
+		exact         bool
+	}{
+		{150, 50, 3, true},
+		{120, 50, 2, false},
+		{-150, 50, -3, true},
+		{30, 50, 0, false},
+		{0, 50, 0, true},
+	}
+
+	for _, tc := range tcs {
+		n, exact, err := New(tc.amount, EUR).DividesEvenly(New(tc.other, EUR))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n != tc.count || exact != tc.exact {
+			t.Errorf("Expected %d / %d to be (%d, %t) got (%d, %t)", tc.amount, tc.other, tc.count, tc.exact, n, exact)
+		}
+	}
+
+	if _, _, err := New(100, EUR).DividesEvenly(New(0, EUR)); err != ErrDivisionByZero {
+		t.Errorf("Expected %v got %v", ErrDivisionByZero, err)
+	}
+
+	if _, _, err := New(100, EUR).DividesEvenly(New(50, USD)); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	huge := NewFromDecimal(decimal.RequireFromString("1e30"), EUR)
+	if _, _, err := huge.DividesEvenly(New(1, EUR)); err != ErrOverflow {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
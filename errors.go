@@ -0,0 +1,41 @@
+package money
+
+import "errors"
+
+// CodedError is implemented by the errors of the package. Its code is stable and machine-readable,
+// such as "money.currency_mismatch", so APIs can map errors to responses without matching messages.
+type CodedError interface {
+	error
+	ErrorCode() string
+}
+
+// codedError is an error with a stable code, used for the sentinel errors of the package.
+type codedError struct {
+	code string
+	msg  string
+}
+
+func newError(code, msg string) error {
+	return &codedError{code: code, msg: msg}
+}
+
+// Error implements error.
+func (e *codedError) Error() string {
+	return e.msg
+}
+
+// ErrorCode implements CodedError.
+func (e *codedError) ErrorCode() string {
+	return e.code
+}
+
+// ErrorCode returns the code of the first CodedError in the chain of err, such as "money.currency_mismatch"
+// for an error wrapping ErrCurrencyMismatch, or an empty string when there is none.
+func ErrorCode(err error) string {
+	var ce CodedError
+	if errors.As(err, &ce) {
+		return ce.ErrorCode()
+	}
+
+	return ""
+}
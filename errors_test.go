@@ -0,0 +1,140 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// errorCodes pins the codes of the sentinel errors: they are part of the API and must never change.
+var errorCodes = map[error]string{
+	ErrAmbiguousCurrency:      "money.ambiguous_currency",
+	ErrCurrencyMismatch:       "money.currency_mismatch",
+	ErrCurrencyNotAllowed:     "money.currency_not_allowed",
+	ErrDivisionByZero:         "money.division_by_zero",
+	ErrEmptyCurrencyCode:      "money.empty_currency_code",
+	ErrGoldenMismatch:         "money.golden_mismatch",
+	ErrInvalidGolden:          "money.invalid_golden",
+	ErrInvalidAmount:          "money.invalid_amount",
+	ErrInvalidBinaryUnmarshal: "money.invalid_binary",
+	ErrInvalidChunk:           "money.invalid_chunk",
+	ErrInvalidCursor:          "money.invalid_cursor",
+	ErrInvalidDBValue:         "money.invalid_db_value",
+	ErrInvalidIncrement:       "money.invalid_increment",
+	ErrInvalidInstallments:    "money.invalid_installments",
+	ErrInvalidJSONUnmarshal:   "money.invalid_json",
+	ErrInvalidLot:             "money.invalid_lot",
+	ErrInvalidRange:           "money.invalid_range",
+	ErrInvalidRate:            "money.invalid_rate",
+	ErrInvalidSplit:           "money.invalid_split",
+	ErrInvalidTaxRate:         "money.invalid_tax_rate",
+	ErrInvalidThreshold:       "money.invalid_threshold",
+	ErrInvalidTick:            "money.invalid_tick",
+	ErrLossyConversion:        "money.lossy_conversion",
+	ErrNegativeRatio:          "money.negative_ratio",
+	ErrNoDivisor:              "money.no_divisor",
+	ErrNoMoney:                "money.no_money",
+	ErrNoRatios:               "money.no_ratios",
+	ErrOverflow:               "money.overflow",
+	ErrRateNotFound:           "money.rate_not_found",
+	ErrRatiosOverflow:         "money.ratios_overflow",
+	ErrRoundingMismatch:       "money.rounding_mismatch",
+	ErrRoundTripMismatch:      "money.round_trip_mismatch",
+	ErrFractionalAmount:       "money.fractional_amount",
+	ErrFieldOverflow:          "money.field_overflow",
+	ErrInvalidField:           "money.invalid_field",
+	ErrTooManyParts:           "money.too_many_parts",
+	ErrNoDefaultCurrency:      "money.no_default_currency",
+	ErrRegistryFrozen:         "money.registry_frozen",
+	ErrUnknownCurrency:        "money.unknown_currency",
+	ErrUnknownLocale:          "money.unknown_locale",
+}
+
+func TestErrorCode(t *testing.T) {
+	seen := map[string]error{}
+	for err, code := range errorCodes {
+		if got := ErrorCode(err); got != code {
+			t.Errorf("Expected %q for %v got %q", code, err, got)
+		}
+
+		if other, ok := seen[code]; ok {
+			t.Errorf("Expected unique codes got %q for %v and %v", code, err, other)
+		}
+		seen[code] = err
+
+		wrapped := fmt.Errorf("context: %w", err)
+		if got := ErrorCode(wrapped); got != code {
+			t.Errorf("Expected %q for wrapped %v got %q", code, err, got)
+		}
+		if !errors.Is(wrapped, err) {
+			t.Errorf("Expected wrapped error to match %v", err)
+		}
+	}
+
+	if code := ErrorCode(errors.New("other")); code != "" {
+		t.Errorf("Expected no code got %q", code)
+	}
+
+	if code := ErrorCode(nil); code != "" {
+		t.Errorf("Expected no code got %q", code)
+	}
+}
+
+func TestErrorCode_Operations(t *testing.T) {
+	_, err := New(100, EUR).Add(New(100, USD))
+	if code := ErrorCode(err); code != "money.currency_mismatch" {
+		t.Errorf("Expected %q got %q", "money.currency_mismatch", code)
+	}
+
+	_, err = New(100, EUR).Allocate()
+	if code := ErrorCode(err); code != "money.no_ratios" {
+		t.Errorf("Expected %q got %q", "money.no_ratios", code)
+	}
+
+	_, err = NewFromString("0.125", EUR)
+	if code := ErrorCode(err); code != "money.precision_exceeded" || !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected %q matching ErrInvalidAmount got %q", "money.precision_exceeded", code)
+	}
+
+	_, err = Parse("kr1.00")
+	if code := ErrorCode(err); code != "money.ambiguous_currency" {
+		t.Errorf("Expected %q got %q", "money.ambiguous_currency", code)
+	}
+
+	var ce CodedError
+	if !errors.As(err, &ce) || ce.Error() != "ambiguous currency" {
+		t.Errorf("Expected the sentinel as CodedError got %v", ce)
+	}
+}
+
+func TestErrorCode_Decoding(t *testing.T) {
+	var m Money
+	var c Currency
+	var p Predicate
+	amount, _ := m.ScanColumns()
+
+	tcs := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"db value", defaultDBScan(&m, "12|"), "money.invalid_db_value"},
+		{"db amount", defaultDBScan(&m, "1x|EUR"), "money.invalid_db_value"},
+		{"db currency", defaultDBScan(&m, "100|ZZZ"), "money.unknown_currency"},
+		{"db type", defaultDBScan(&m, 100), "money.invalid_db_value"},
+		{"json db type", JSONDBScan(&m, 100), "money.invalid_db_value"},
+		{"amount column", amount.(interface{ Scan(interface{}) error }).Scan("1x"), "money.invalid_db_value"},
+		{"currency type", c.Scan(100), "money.invalid_db_value"},
+		{"currency code", c.Scan("ZZZ"), "money.unknown_currency"},
+		{"predicate", p.UnmarshalJSON([]byte(`{"conditions":[{"op":"~"}]}`)), "money.invalid_json"},
+		{"golden fields", VerifyGolden(strings.NewReader("EUR\t1\n")), "money.invalid_golden"},
+		{"golden amount", VerifyGolden(strings.NewReader("EUR\tx\t€0.01\n")), "money.invalid_golden"},
+	}
+
+	for _, tc := range tcs {
+		if code := ErrorCode(tc.err); code != tc.code {
+			t.Errorf("%s: expected %q got %q for %v", tc.name, tc.code, code, tc.err)
+		}
+	}
+}
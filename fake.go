@@ -0,0 +1,123 @@
+package money
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// fakeCurrencies are the codes a Faker picks from by default, widely used currencies of various magnitudes.
+var fakeCurrencies = []string{USD, EUR, GBP, JPY, CAD, AUD, CHF, CNY, INR, BRL, MXN, KRW, SEK, PLN}
+
+// fakeScales are rough amounts of a currency worth one US dollar, only used to give fake amounts a plausible
+// order of magnitude. Currencies not listed are taken as worth about one dollar.
+var fakeScales = map[string]float64{
+	AUD: 1.5, BRL: 5, CAD: 1.4, CLP: 900, CNY: 7, COP: 4000, CZK: 23, DKK: 7, HKD: 8, HUF: 350, IDR: 15000,
+	INR: 80, ISK: 140, JPY: 150, KRW: 1300, MXN: 17, NOK: 10, NZD: 1.6, PHP: 55, PLN: 4, RUB: 90, SEK: 10,
+	THB: 35, TRY: 30, TWD: 30, UAH: 40, VND: 25000, ZAR: 18,
+}
+
+// Faker generates random but realistic Money, such as seed data for demo environments and load tests:
+// codes of registered currencies and amounts of a plausible magnitude for each currency, e.g. ¥1,500 rather
+// than ¥15. It doesn't depend on any faker library; Provider plugs it into those taking provider functions.
+// A Faker is safe for concurrent use.
+type Faker struct {
+	// Currencies are the codes Money is generated in, a list of widely used currencies when empty.
+	Currencies []string
+
+	// Min and Max bound the amounts, in major units of a currency worth about one US dollar; amounts in other
+	// currencies are scaled by their rough value. They default to 1 and 1000.
+	Min, Max float64
+
+	// Registry holds the currencies; the DefaultRegistry is used when nil.
+	Registry *Registry
+
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewFaker creates a Faker generating the same Money for the same seed, so seeded data can be reproduced.
+func NewFaker(seed int64) *Faker {
+	return &Faker{rand: rand.New(rand.NewSource(seed))}
+}
+
+// Money returns random Money in one of the currencies of the faker.
+func (f *Faker) Money() *Money {
+	cs := f.Currencies
+	if len(cs) == 0 {
+		cs = fakeCurrencies
+	}
+
+	return f.MoneyIn(cs[f.intn(len(cs))])
+}
+
+// MoneyIn returns random Money in the currency with the given code. Amounts are spread evenly over orders
+// of magnitude between the bounds, then rounded to whole minor units.
+func (f *Faker) MoneyIn(code string) *Money {
+	c := f.registry().get(code)
+
+	lo, hi := f.Min, f.Max
+	if lo <= 0 {
+		lo = 1
+	}
+	if hi <= lo {
+		hi = lo * 1000
+	}
+
+	scale, ok := fakeScales[c.Code]
+	if !ok {
+		scale = 1
+	}
+
+	amount := math.Exp(math.Log(lo)+f.float64()*(math.Log(hi)-math.Log(lo))) * scale
+	return &Money{amount: decimal.NewFromFloat(amount).Round(int32(c.Fraction)).Shift(int32(c.Fraction)), currency: c}
+}
+
+// Provider generates random Money for a struct field of type Money or *Money. It has the signature of the
+// custom providers of faker libraries such as github.com/go-faker/faker, e.g.
+//
+//	faker.AddProvider("money", money.NewFaker(42).Provider)
+func (f *Faker) Provider(v reflect.Value) (interface{}, error) {
+	switch v.Type() {
+	case reflect.TypeOf(Money{}):
+		return *f.Money(), nil
+	case reflect.TypeOf(&Money{}):
+		return f.Money(), nil
+	}
+
+	return nil, fmt.Errorf("money: can't fake a %s", v.Type())
+}
+
+func (f *Faker) intn(n int) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.source().Intn(n)
+}
+
+func (f *Faker) float64() float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.source().Float64()
+}
+
+// source returns the random source of the faker, seeded with the current time for a zero Faker.
+func (f *Faker) source() *rand.Rand {
+	if f.rand == nil {
+		f.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return f.rand
+}
+
+func (f *Faker) registry() *Registry {
+	if f.Registry == nil {
+		return DefaultRegistry
+	}
+
+	return f.Registry
+}
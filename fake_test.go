@@ -0,0 +1,72 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFaker_Money(t *testing.T) {
+	f := NewFaker(42)
+	g := NewFaker(42)
+
+	for i := 0; i < 1000; i++ {
+		m := f.Money()
+		if n := g.Money(); n.Currency() != m.Currency() || n.CompareAmount(m) != 0 {
+			t.Fatalf("Expected the same seed to give the same Money got %v and %v", m.Display(), n.Display())
+		}
+
+		if !m.IsPositive() || m.CheckPrecision() != nil {
+			t.Errorf("Expected a positive amount in whole minor units got %s", m.DebugString())
+		}
+
+		lo, hi := 1.0, 1000.0
+		if s, ok := fakeScales[m.Currency().Code]; ok {
+			lo, hi = lo*s, hi*s
+		}
+		if v := m.AsMajorUnits(); v < lo*0.99 || v > hi*1.01 {
+			t.Errorf("Expected %s between %v and %v", m.Display(), lo, hi)
+		}
+	}
+}
+
+func TestFaker_MoneyIn(t *testing.T) {
+	f := &Faker{Currencies: []string{JPY}, Min: 10, Max: 20}
+
+	for i := 0; i < 100; i++ {
+		m := f.Money()
+		if m.Currency().Code != JPY {
+			t.Fatalf("Expected %s got %s", JPY, m.Currency().Code)
+		}
+		if m.Amount() < 1500 || m.Amount() > 3000 {
+			t.Errorf("Expected a plausible yen amount got %s", m.Display())
+		}
+	}
+
+	if m := f.MoneyIn(EUR); m.Amount() < 1000 || m.Amount() > 2000 {
+		t.Errorf("Expected between €10 and €20 got %s", m.Display())
+	}
+}
+
+func TestFaker_Provider(t *testing.T) {
+	var s struct {
+		Price *Money
+		Total Money
+		Name  string
+	}
+	f := NewFaker(1)
+	v := reflect.ValueOf(&s).Elem()
+
+	p, err := f.Provider(v.Field(0))
+	if m, ok := p.(*Money); err != nil || !ok || m.Currency() == nil {
+		t.Errorf("Expected *Money got %v, %v", p, err)
+	}
+
+	p, err = f.Provider(v.Field(1))
+	if m, ok := p.(Money); err != nil || !ok || m.Currency() == nil {
+		t.Errorf("Expected Money got %v, %v", p, err)
+	}
+
+	if _, err := f.Provider(v.Field(2)); err == nil {
+		t.Error("Expected an error for a string field")
+	}
+}
@@ -0,0 +1,100 @@
+package money
+
+import (
+	"math"
+	"math/bits"
+)
+
+// maxFixedShift is the largest difference between two exponents fixedPair aligns: 10^19 still fits an uint64,
+// and an 18-digit coefficient scaled by it stays far below 2^127.
+const maxFixedShift = 19
+
+// pow10 holds the powers of ten up to 10^maxFixedShift.
+var pow10 = func() [maxFixedShift + 1]uint64 {
+	var p [maxFixedShift + 1]uint64
+	p[0] = 1
+	for i := 1; i < len(p); i++ {
+		p[i] = p[i-1] * 10
+	}
+
+	return p
+}()
+
+// int128 is a two's complement 128-bit integer, wide enough to add, subtract and compare amounts aligned
+// to a common exponent without overflowing.
+type int128 struct {
+	hi int64
+	lo uint64
+}
+
+// scaled returns x × p as an int128.
+func scaled(x int64, p uint64) int128 {
+	ux := uint64(x)
+	if x < 0 {
+		ux = -ux
+	}
+
+	hi, lo := bits.Mul64(ux, p)
+	r := int128{hi: int64(hi), lo: lo}
+	if x < 0 {
+		return r.neg()
+	}
+
+	return r
+}
+
+func (a int128) neg() int128 {
+	lo, borrow := bits.Sub64(0, a.lo, 0)
+	return int128{hi: -a.hi - int64(borrow), lo: lo}
+}
+
+func (a int128) add(b int128) int128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	return int128{hi: a.hi + b.hi + int64(carry), lo: lo}
+}
+
+func (a int128) sub(b int128) int128 {
+	return a.add(b.neg())
+}
+
+func (a int128) cmp(b int128) int {
+	switch {
+	case a.hi < b.hi, a.hi == b.hi && a.lo < b.lo:
+		return -1
+	case a == b:
+		return 0
+	}
+
+	return 1
+}
+
+// int64 returns a as an int64, if it fits one.
+func (a int128) int64() (int64, bool) {
+	if (a.hi == 0 && a.lo <= math.MaxInt64) || (a.hi == -1 && a.lo > math.MaxInt64) {
+		return int64(a.lo), true
+	}
+
+	return 0, false
+}
+
+// fixedPair returns a and b as 128-bit fixed-point coefficients of their common, smallest exponent, so that amounts
+// carrying precision beyond the currency fraction, e.g. 1.005 EUR next to 2 EUR, are added, subtracted and compared
+// without big numbers. It applies when both coefficients have at most 18 digits and their exponents are at most
+// maxFixedShift apart, which covers nearly all amounts; callers fall back to decimal otherwise.
+func fixedPair(a, b Amount) (x, y int128, exp int32, ok bool) {
+	if a.NumDigits() > 18 || b.NumDigits() > 18 {
+		return x, y, 0, false
+	}
+
+	ea, eb := a.Exponent(), b.Exponent()
+	shift := int64(ea) - int64(eb)
+	if shift > maxFixedShift || shift < -maxFixedShift {
+		return x, y, 0, false
+	}
+
+	if shift >= 0 {
+		return scaled(a.CoefficientInt64(), pow10[shift]), scaled(b.CoefficientInt64(), 1), eb, true
+	}
+
+	return scaled(a.CoefficientInt64(), 1), scaled(b.CoefficientInt64(), pow10[-shift]), ea, true
+}
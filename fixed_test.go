@@ -0,0 +1,91 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func (a int128) big() *big.Int {
+	r := new(big.Int).Lsh(big.NewInt(a.hi), 64)
+	return r.Add(r, new(big.Int).SetUint64(a.lo))
+}
+
+func TestInt128(t *testing.T) {
+	values := []int64{0, 1, -1, 42, -42, math.MaxInt64, math.MinInt64, 999999999999999999, -999999999999999999}
+	powers := []uint64{1, 10, 1000, pow10[maxFixedShift]}
+
+	for _, x := range values {
+		for _, p := range powers {
+			a := scaled(x, p)
+			expected := new(big.Int).Mul(big.NewInt(x), new(big.Int).SetUint64(p))
+			if a.big().Cmp(expected) != 0 {
+				t.Fatalf("Expected %d × %d = %s got %s", x, p, expected, a.big())
+			}
+
+			for _, y := range values {
+				b := scaled(y, 1)
+				if sum := new(big.Int).Add(expected, big.NewInt(y)); a.add(b).big().Cmp(sum) != 0 {
+					t.Errorf("Expected %s + %d = %s got %s", expected, y, sum, a.add(b).big())
+				}
+
+				if diff := new(big.Int).Sub(expected, big.NewInt(y)); a.sub(b).big().Cmp(diff) != 0 {
+					t.Errorf("Expected %s - %d = %s got %s", expected, y, diff, a.sub(b).big())
+				}
+
+				if c := a.cmp(b); c != expected.Cmp(big.NewInt(y)) {
+					t.Errorf("Expected %s compared to %d == %d got %d", expected, y, expected.Cmp(big.NewInt(y)), c)
+				}
+			}
+
+			r, ok := a.int64()
+			if fits := expected.IsInt64(); ok != fits || (ok && r != expected.Int64()) {
+				t.Errorf("Expected %s to fit an int64 %t got %d %t", expected, fits, r, ok)
+			}
+		}
+	}
+}
+
+func TestFixedPair(t *testing.T) {
+	d := decimal.RequireFromString
+
+	tcs := []struct {
+		a, b Amount
+		ok   bool
+		exp  int32
+	}{
+		{d("100"), d("250"), true, 0},
+		{d("100.5"), d("250"), true, -1},
+		{d("100"), d("0.0025"), true, -4},
+		{decimal.Zero, d("-7"), true, 0},
+		{d("1e19"), d("1"), true, 0},
+		{d("1e20"), d("1"), false, 0},
+		{d("1234567890123456789"), d("1"), false, 0},
+	}
+
+	for _, tc := range tcs {
+		x, y, exp, ok := fixedPair(tc.a, tc.b)
+		if ok != tc.ok {
+			t.Errorf("Expected fixedPair(%s, %s) to apply %t got %t", tc.a, tc.b, tc.ok, ok)
+			continue
+		}
+
+		if !ok {
+			continue
+		}
+
+		if exp != tc.exp {
+			t.Errorf("Expected exponent %d got %d", tc.exp, exp)
+		}
+
+		if a := decimal.NewFromBigInt(x.big(), exp); !a.Equal(tc.a) {
+			t.Errorf("Expected %s got %s", tc.a, a)
+		}
+
+		if b := decimal.NewFromBigInt(y.big(), exp); !b.Equal(tc.b) {
+			t.Errorf("Expected %s got %s", tc.b, b)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// FloatLoss reports a value of FromFloatColumn that can't be trusted to the minor unit.
+// Error is the largest distance, in major units, between the converted Money and the amounts the float may
+// stand for: the rounding applied, plus half the gap to the neighbouring float64 values.
+type FloatLoss struct {
+	Index int
+	Value float64
+	Money *Money
+	Error decimal.Decimal
+}
+
+// FromFloatColumn converts a column of legacy float64 amounts in major units into Money, for cleaning up data
+// that used to be stored as floats. Each float is read as its shortest decimal representation, e.g. 0.1 rather
+// than 0.1000000000000000055511151231257827, and rounded to whole minor units using the given rounding mode,
+// RoundHalfUp by default.
+//
+// Values whose representation error goes beyond half a minor unit are reported as FloatLoss: ties such as 10.005
+// EUR, which the float may hold either side of, and amounts too large for float64 to tell neighbouring minor units
+// apart. NaN and infinities are rejected with ErrInvalidAmount.
+func FromFloatColumn(values []float64, code string, mode ...RoundingMode) ([]*Money, []FloatLoss, error) {
+	return FromFloatColumnContext(context.Background(), values, code, mode...)
+}
+
+// FromFloatColumnContext is FromFloatColumn checking ctx between chunks of values, so that converting a large
+// column can be aborted; it then returns ctx.Err().
+func FromFloatColumnContext(ctx context.Context, values []float64, code string, mode ...RoundingMode) ([]*Money, []FloatLoss, error) {
+	c := newCurrency(code).get()
+	half := decimal.New(5, -int32(c.Fraction)-1)
+
+	ms := make([]*Money, len(values))
+	losses := []FloatLoss{}
+	for i, v := range values {
+		if i%bulkChunk == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, nil, fmt.Errorf("%w: row %d is %v", ErrInvalidAmount, i, v)
+		}
+
+		d := decimal.NewFromFloat(v)
+		m := (&Money{amount: d.Shift(int32(c.Fraction)), currency: c}).RoundToCurrency(mode...)
+		ms[i] = m
+
+		e := d.Sub(m.Decimal()).Abs().Add(decimal.NewFromFloat(ulp(v) / 2))
+		if e.GreaterThan(half) {
+			losses = append(losses, FloatLoss{Index: i, Value: v, Money: m, Error: e})
+		}
+	}
+
+	return ms, losses, nil
+}
+
+// ulp returns the gap between |v| and the next larger float64, or the next smaller one for the largest float64.
+func ulp(v float64) float64 {
+	v = math.Abs(v)
+	if next := math.Nextafter(v, math.Inf(1)); !math.IsInf(next, 1) {
+		return next - v
+	}
+
+	return v - math.Nextafter(v, 0)
+}
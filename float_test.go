@@ -0,0 +1,55 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestFromFloatColumn(t *testing.T) {
+	values := []float64{0.1, 0.1 + 0.2, 19.99, -4.004, 10.005, 1e15, 0, 123.456}
+
+	ms, losses, err := FromFloatColumn(values, EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int64{10, 30, 1999, -400, 1001, 1e17, 0, 12346}
+	for i, m := range ms {
+		if m.Amount() != expected[i] || m.Currency().Code != EUR {
+			t.Errorf("Expected %v to convert to %d got %d", values[i], expected[i], m.Amount())
+		}
+	}
+
+	if len(losses) != 2 {
+		t.Fatalf("Expected 2 losses got %v", losses)
+	}
+
+	if l := losses[0]; l.Index != 4 || l.Value != 10.005 || l.Money != ms[4] || l.Error.LessThan(decimal.RequireFromString("0.005")) {
+		t.Errorf("Expected the 10.005 tie to be reported got %+v", l)
+	}
+
+	if l := losses[1]; l.Index != 5 || l.Error.String() != "0.0625" {
+		t.Errorf("Expected 1e15 to be reported with an error of 0.0625 got %+v", l)
+	}
+
+	ms, _, err = FromFloatColumn([]float64{10.005}, EUR, RoundHalfEven)
+	if err != nil || ms[0].Amount() != 1000 {
+		t.Errorf("Expected 1000 got %v %v", ms, err)
+	}
+
+	ms, losses, err = FromFloatColumn([]float64{1.5, math.MaxFloat64}, JPY)
+	if err != nil || ms[0].Amount() != 2 || len(losses) != 2 {
+		t.Errorf("Expected both values to be reported got %v %v", losses, err)
+	}
+}
+
+func TestFromFloatColumn_Invalid(t *testing.T) {
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, _, err := FromFloatColumn([]float64{1, v}, EUR); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected %v for %v got %v", ErrInvalidAmount, v, err)
+		}
+	}
+}
@@ -15,21 +15,22 @@ type Formatter struct {
 	Template string
 }
 
-// NewFormatter creates new Formatter instance.
+// NewFormatter creates new Formatter instance, with its separators, grapheme and template in Unicode NFC.
 func NewFormatter(fraction int, decimal, thousand, grapheme, template string) *Formatter {
 	return &Formatter{
 		Fraction: fraction,
-		Decimal:  decimal,
-		Thousand: thousand,
-		Grapheme: grapheme,
-		Template: template,
+		Decimal:  normalize(decimal),
+		Thousand: normalize(thousand),
+		Grapheme: normalize(grapheme),
+		Template: normalize(template),
 	}
 }
 
-// Format returns string of formatted integer using given currency template.
+// Format returns string of formatted integer using given currency template. The output is in Unicode NFC when
+// the fields of the formatter are, as for formatters of registered currencies and those created by NewFormatter.
 func (f *Formatter) Format(amount int64) string {
-	// Work with absolute amount value
-	sa := strconv.FormatInt(f.abs(amount), 10)
+	// Work with absolute amount value, which doesn't fit an int64 for math.MinInt64
+	sa := strings.TrimPrefix(strconv.FormatInt(amount, 10), "-")
 
 	if len(sa) <= f.Fraction {
 		sa = strings.Repeat("0", f.Fraction-len(sa)+1) + sa
@@ -63,12 +64,3 @@ func (f *Formatter) ToMajorUnits(amount int64) float64 {
 
 	return float64(amount) / float64(math.Pow10(f.Fraction))
 }
-
-// abs return absolute value of given integer.
-func (f Formatter) abs(amount int64) int64 {
-	if amount < 0 {
-		return -amount
-	}
-
-	return amount
-}
@@ -1,5 +1,9 @@
 module github.com/noho-digital/go-money
 
-go 1.13
+go 1.17
 
-require github.com/shopspring/decimal v1.4.0
+require (
+	github.com/cockroachdb/apd/v3 v3.2.1
+	github.com/shopspring/decimal v1.4.0
+	golang.org/x/text v0.13.0
+)
@@ -0,0 +1,114 @@
+package money
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrGoldenMismatch happens when formatting doesn't match a golden file anymore.
+	ErrGoldenMismatch = newError("money.golden_mismatch", "formatting differs from golden file")
+
+	// ErrInvalidGolden happens when a golden file holds a line that WriteGolden can't have written.
+	ErrInvalidGolden = newError("money.invalid_golden", "invalid golden file")
+)
+
+// goldenAmounts are the amounts WriteGolden formats when none are given.
+// Changing them changes every golden file, so only ever append.
+var goldenAmounts = []int64{0, 1, -1, 5, 12, -99, 100, 1234, -123456, 123456789, math.MaxInt64, math.MinInt64}
+
+// WriteGolden writes a golden file of how every currency of the DefaultRegistry displays a set of amounts,
+// see Registry.WriteGolden.
+func WriteGolden(w io.Writer, amounts ...int64) error {
+	return DefaultRegistry.WriteGolden(w, amounts...)
+}
+
+// VerifyGolden checks that every currency of the DefaultRegistry still displays amounts as recorded in a
+// golden file, see Registry.VerifyGolden.
+func VerifyGolden(golden io.Reader) error {
+	return DefaultRegistry.VerifyGolden(golden)
+}
+
+// WriteGolden writes a golden file of how every currency of the registry displays the given amounts of minor units,
+// or a fixed set of edge cases when none are given. Each line holds a currency code, an amount and its Display,
+// separated by tabs, ordered by code.
+//
+// Commit the golden file with your tests and check it with VerifyGolden, so upgrades can't change how your
+// currencies are displayed without you noticing:
+//
+//	f, err := os.Open("testdata/currencies.golden")
+//	...
+//	if err := registry.VerifyGolden(f); err != nil {
+//		t.Error(err)
+//	}
+func (r *Registry) WriteGolden(w io.Writer, amounts ...int64) error {
+	if len(amounts) == 0 {
+		amounts = goldenAmounts
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, c := range r.sorted() {
+		for _, amount := range amounts {
+			fmt.Fprintf(bw, "%s\t%d\t%s\n", c.Code, amount, r.New(amount, c.Code).Display())
+		}
+	}
+
+	return bw.Flush()
+}
+
+// VerifyGolden checks that every currency of the registry still displays amounts as recorded in a golden file
+// written by WriteGolden. It returns an error wrapping ErrGoldenMismatch that lists every line whose Display
+// changed, every currency of the file that isn't registered anymore and every registered currency missing
+// from the file.
+func (r *Registry) VerifyGolden(golden io.Reader) error {
+	var diffs []string
+	seen := map[string]bool{}
+
+	s := bufio.NewScanner(golden)
+	for line := 1; s.Scan(); line++ {
+		if s.Text() == "" {
+			continue
+		}
+
+		fields := strings.Split(s.Text(), "\t")
+		if len(fields) != 3 {
+			return fmt.Errorf("%w: line %d: %q is not a code, amount and display", ErrInvalidGolden, line, s.Text())
+		}
+
+		amount, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: line %d: %v", ErrInvalidGolden, line, err)
+		}
+
+		code := fields[0]
+		seen[code] = true
+		if r.GetCurrency(code) == nil {
+			diffs = append(diffs, fmt.Sprintf("line %d: %s is not registered", line, code))
+			continue
+		}
+
+		if got := r.New(amount, code).Display(); got != fields[2] {
+			diffs = append(diffs, fmt.Sprintf("line %d: %s %d displays %q, want %q", line, code, amount, got, fields[2]))
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	for _, c := range r.sorted() {
+		if !seen[c.Code] {
+			diffs = append(diffs, fmt.Sprintf("%s is missing from the golden file", c.Code))
+		}
+	}
+
+	if len(diffs) > 0 {
+		return fmt.Errorf("%w:\n%s", ErrGoldenMismatch, strings.Join(diffs, "\n"))
+	}
+
+	return nil
+}
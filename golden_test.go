@@ -0,0 +1,84 @@
+package money
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGolden(t *testing.T) {
+	golden := filepath.Join("testdata", "currencies.golden")
+	r := NewRegistry()
+
+	if *update {
+		var b bytes.Buffer
+		if err := r.WriteGolden(&b); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(golden, b.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := r.VerifyGolden(f); err != nil {
+		t.Errorf("%v\n\nrun go test -run TestGolden -update if the change is intended", err)
+	}
+}
+
+func TestVerifyGolden_Mismatch(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := r.WriteGolden(&b, 1500); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(b.String(), "PTS\t1500\t1,500 pts\n") {
+		t.Errorf("Expected the PTS line in the golden file got\n%s", b.String())
+	}
+
+	if err := r.VerifyGolden(bytes.NewReader(b.Bytes())); err != nil {
+		t.Errorf("Expected the golden file to verify got %v", err)
+	}
+
+	if _, err := r.AddCurrency("PTS", "points", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.AddCurrency("NEW", "new", "1 $", ".", ",", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	golden := b.String() + "OLD\t1\t0.01 old\n"
+	err := r.VerifyGolden(strings.NewReader(golden))
+	if !errors.Is(err, ErrGoldenMismatch) {
+		t.Fatalf("Expected %v got %v", ErrGoldenMismatch, err)
+	}
+
+	for _, diff := range []string{`PTS 1500 displays "1,500 points", want "1,500 pts"`, "OLD is not registered", "NEW is missing from the golden file"} {
+		if !strings.Contains(err.Error(), diff) {
+			t.Errorf("Expected %q in %v", diff, err)
+		}
+	}
+
+	if err := r.VerifyGolden(strings.NewReader("EUR\tten\t€10.00\n")); err == nil || errors.Is(err, ErrGoldenMismatch) {
+		t.Errorf("Expected a malformed golden file error got %v", err)
+	}
+}
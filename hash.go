@@ -0,0 +1,20 @@
+package money
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+)
+
+// Hash64 returns a stable 64-bit hash of Money for sketch data structures, such as Bloom filters or
+// HyperLogLog, and for sharding by amount and currency. It is the 64-bit FNV-1a hash of the seed written as
+// 8 little-endian bytes followed by SortableBytes, so equal amounts in the same currency hash equally
+// whatever their internal representation, and the value won't change between releases or processes.
+func (m *Money) Hash64(seed uint64) uint64 {
+	var s [8]byte
+	binary.LittleEndian.PutUint64(s[:], seed)
+
+	h := fnv.New64a()
+	h.Write(s[:])
+	h.Write(m.SortableBytes())
+	return h.Sum64()
+}
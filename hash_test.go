@@ -0,0 +1,42 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_Hash64(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		seed     uint64
+		expected uint64
+	}{
+		{New(0, EUR), 0, 0x948d0debebe8a6c7},
+		{New(123456, EUR), 0, 0x815dbbda3b364fb7},
+		{New(123456, EUR), 42, 0x91a6e65c85344395},
+		{New(-123456, USD), 0, 0x0850fde3403f9f6c},
+	}
+
+	for _, tc := range tcs {
+		if h := tc.money.Hash64(tc.seed); h != tc.expected {
+			t.Errorf("Expected hash of %s with seed %d to be %#x got %#x", tc.money.Display(), tc.seed, tc.expected, h)
+		}
+	}
+}
+
+func TestMoney_Hash64Equality(t *testing.T) {
+	a := New(1200, EUR)
+	b := NewFromDecimal(decimal.RequireFromString("12.000"), EUR)
+	if a.Hash64(7) != b.Hash64(7) {
+		t.Error("Expected equal amounts to hash equally")
+	}
+
+	if a.Hash64(7) == New(1200, USD).Hash64(7) {
+		t.Error("Expected different currencies to hash differently")
+	}
+
+	if a.Hash64(7) == a.Hash64(8) {
+		t.Error("Expected different seeds to hash differently")
+	}
+}
@@ -0,0 +1,39 @@
+package money
+
+// ErrInvalidIncrement happens when an increment, such as a tick size, isn't positive.
+var ErrInvalidIncrement = newError("money.invalid_increment", "increment must be positive")
+
+// IsMultipleOf checks whether Money is a whole multiple of the increment, e.g. whether a price respects
+// the €0.05 tick size of an order book. The increment must be positive and in the same currency.
+func (m *Money) IsMultipleOf(increment *Money) (bool, error) {
+	if err := m.checkIncrement(increment); err != nil {
+		return false, err
+	}
+
+	_, r := mutate.calc.quoRem(m.amount, increment.amount)
+	return r.IsZero(), nil
+}
+
+// SnapTo returns new Money struct with value rounded to a whole multiple of the increment using the given
+// rounding mode, e.g. €10.03 snaps to €10.05 with RoundHalfUp and a €0.05 increment.
+// The increment must be positive and in the same currency.
+func (m *Money) SnapTo(increment *Money, mode RoundingMode) (*Money, error) {
+	if err := m.checkIncrement(increment); err != nil {
+		return nil, err
+	}
+
+	n := mutate.calc.divideRound(m.amount, increment.amount, mode)
+	return &Money{amount: mutate.calc.multiplyDecimal(increment.amount, n), currency: m.currency}, nil
+}
+
+func (m *Money) checkIncrement(increment *Money) error {
+	if err := m.assertSameCurrency(increment); err != nil {
+		return err
+	}
+
+	if !increment.amount.IsPositive() {
+		return ErrInvalidIncrement
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_IsMultipleOf(t *testing.T) {
+	tcs := []struct {
+		amount, increment int64
+		expected          bool
+	}{
+		{1005, 5, true},
+		{1003, 5, false},
+		{-1005, 5, true},
+		{0, 5, true},
+		{100, 100, true},
+		{50, 100, false},
+	}
+
+	for _, tc := range tcs {
+		ok, err := New(tc.amount, EUR).IsMultipleOf(New(tc.increment, EUR))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ok != tc.expected {
+			t.Errorf("Expected %d to be a multiple of %d %t got %t", tc.amount, tc.increment, tc.expected, ok)
+		}
+	}
+
+	half := NewFromDecimal(decimal.RequireFromString("0.005"), EUR)
+	if ok, _ := NewFromDecimal(decimal.RequireFromString("1.015"), EUR).IsMultipleOf(half); !ok {
+		t.Error("Expected €1.015 to be a multiple of €0.005")
+	}
+}
+
+func TestMoney_SnapTo(t *testing.T) {
+	tcs := []struct {
+		amount, increment int64
+		mode              RoundingMode
+		expected          int64
+	}{
+		{1003, 5, RoundHalfUp, 1005},
+		{1002, 5, RoundHalfUp, 1000},
+		{1002, 5, RoundCeiling, 1005},
+		{1025, 50, RoundHalfEven, 1000},
+		{1075, 50, RoundHalfEven, 1100},
+		{-1003, 5, RoundHalfUp, -1005},
+		{-1003, 5, RoundFloor, -1005},
+		{-1003, 5, RoundTruncate, -1000},
+		{1000, 5, RoundCeiling, 1000},
+	}
+
+	for _, tc := range tcs {
+		m, err := New(tc.amount, EUR).SnapTo(New(tc.increment, EUR), tc.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.Amount() != tc.expected || m.Currency().Code != EUR {
+			t.Errorf("Expected %d snapped to %d with %s to be %d got %d", tc.amount, tc.increment, tc.mode, tc.expected, m.Amount())
+		}
+	}
+}
+
+func TestMoney_IncrementErrors(t *testing.T) {
+	m := New(100, EUR)
+
+	if _, err := m.IsMultipleOf(New(5, USD)); err != ErrCurrencyMismatch {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.SnapTo(New(0, EUR), RoundHalfUp); err != ErrInvalidIncrement {
+		t.Errorf("Expected %v got %v", ErrInvalidIncrement, err)
+	}
+
+	if _, err := m.IsMultipleOf(New(-5, EUR)); err != ErrInvalidIncrement {
+		t.Errorf("Expected %v got %v", ErrInvalidIncrement, err)
+	}
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInvalidChunk happens when splitting Money into chunks of a size that isn't positive.
+	ErrInvalidChunk = newError("money.invalid_chunk", "chunk must be higher than zero")
+
+	// ErrInvalidInstallments happens when splitting Money into less than one installment.
+	ErrInvalidInstallments = newError("money.invalid_installments", "installments must be higher than zero")
+
+	// ErrTooManyParts happens when splitting Money by amount would give more than MaxSplitParts parts.
+	ErrTooManyParts = newError("money.too_many_parts", "too many parts")
+)
+
+// MaxSplitParts bounds how many parts SplitByAmount returns, so that a tiny chunk, e.g. from untrusted input,
+// can't make it allocate without limit.
+var MaxSplitParts int64 = 1000000
+
+// SplitByAmount returns slice of Money structs splitting Self into chunks of the given size, followed by
+// whatever is left, e.g. £1,000 in chunks of £300 gives £300, £300, £300 and £100. The chunk must be positive
+// and in the same currency; the parts of a negative amount are negative. Zero Money gives no parts.
+// ErrTooManyParts is returned when there would be more than MaxSplitParts parts. Only whole minor units are split; any precision beyond the currency fraction is truncated first.
+func (m *Money) SplitByAmount(chunk *Money) ([]*Money, error) {
+	if err := m.assertSameCurrency(chunk); err != nil {
+		return nil, err
+	}
+
+	size := chunk.amount.Truncate(0)
+	if !size.IsPositive() {
+		return nil, ErrInvalidChunk
+	}
+
+	t := m.amount.Truncate(0)
+	if t.IsNegative() {
+		size = size.Neg()
+	}
+
+	n, rest := t.QuoRem(size, 0)
+	parts := n
+	if !rest.IsZero() {
+		parts = parts.Add(decimal.NewFromInt(1))
+	}
+	if parts.GreaterThan(decimal.NewFromInt(MaxSplitParts)) {
+		return nil, fmt.Errorf("%w: %s parts, at most %d", ErrTooManyParts, parts, MaxSplitParts)
+	}
+
+	ms := make([]*Money, 0, parts.IntPart())
+	for i := int64(0); i < n.IntPart(); i++ {
+		ms = append(ms, &Money{amount: size, currency: m.currency})
+	}
+
+	if !rest.IsZero() {
+		ms = append(ms, &Money{amount: rest, currency: m.currency})
+	}
+
+	return ms, nil
+}
+
+// Installments returns slice of n Money structs splitting Self into equal installments.
+// Unlike Split, which spreads leftover pennies over the first parties, the whole remainder lands on a single
+// installment: the first one when remainderFirst is set, the last one otherwise.
+// Only whole minor units are split; any precision beyond the currency fraction is truncated first.
+func (m *Money) Installments(n int, remainderFirst bool) ([]*Money, error) {
+	if n <= 0 {
+		return nil, ErrInvalidInstallments
+	}
+
+	t := m.amount.Truncate(0)
+	a, rest := t.QuoRem(decimal.NewFromInt(int64(n)), 0)
+
+	ms := make([]*Money, n)
+	for i := range ms {
+		ms[i] = &Money{amount: a, currency: m.currency}
+	}
+
+	p := n - 1
+	if remainderFirst {
+		p = 0
+	}
+	ms[p].amount = mutate.calc.add(a, rest)
+
+	return ms, nil
+}
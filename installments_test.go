@@ -0,0 +1,98 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoney_SplitByAmount(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		chunk    int64
+		expected []int64
+	}{
+		{100000, 30000, []int64{30000, 30000, 30000, 10000}},
+		{90000, 30000, []int64{30000, 30000, 30000}},
+		{100, 300, []int64{100}},
+		{-1000, 300, []int64{-300, -300, -300, -100}},
+		{0, 300, []int64{}},
+	}
+
+	for _, tc := range tcs {
+		ms, err := New(tc.amount, GBP).SplitByAmount(New(tc.chunk, GBP))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(ms) != len(tc.expected) {
+			t.Errorf("Expected %d parts of %d in chunks of %d got %d", len(tc.expected), tc.amount, tc.chunk, len(ms))
+			continue
+		}
+
+		for i, m := range ms {
+			if m.Amount() != tc.expected[i] {
+				t.Errorf("Expected split of %d in chunks of %d to be %v, part %d got %d", tc.amount, tc.chunk, tc.expected, i, m.Amount())
+			}
+		}
+	}
+}
+
+func TestMoney_SplitByAmountErrors(t *testing.T) {
+	m := New(1000, GBP)
+
+	if _, err := m.SplitByAmount(New(100, EUR)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := m.SplitByAmount(New(0, GBP)); err == nil {
+		t.Error("Expected error splitting in chunks of zero")
+	}
+
+	if _, err := m.SplitByAmount(New(-100, GBP)); err == nil {
+		t.Error("Expected error splitting in negative chunks")
+	}
+
+	if _, err := New(math.MaxInt64, GBP).SplitByAmount(New(1, GBP)); !errors.Is(err, ErrTooManyParts) {
+		t.Errorf("Expected %v got %v", ErrTooManyParts, err)
+	}
+	if _, err := New(MaxSplitParts*100+1, GBP).SplitByAmount(New(100, GBP)); !errors.Is(err, ErrTooManyParts) {
+		t.Errorf("Expected %v got %v", ErrTooManyParts, err)
+	}
+	if ms, err := New(MaxSplitParts*100, GBP).SplitByAmount(New(100, GBP)); err != nil || int64(len(ms)) != MaxSplitParts {
+		t.Errorf("Expected %d parts got %d, %v", MaxSplitParts, len(ms), err)
+	}
+}
+
+func TestMoney_Installments(t *testing.T) {
+	tcs := []struct {
+		amount         int64
+		n              int
+		remainderFirst bool
+		expected       []int64
+	}{
+		{100000, 3, true, []int64{33334, 33333, 33333}},
+		{100000, 3, false, []int64{33333, 33333, 33334}},
+		{100, 7, true, []int64{16, 14, 14, 14, 14, 14, 14}},
+		{100, 7, false, []int64{14, 14, 14, 14, 14, 14, 16}},
+		{-100, 3, false, []int64{-33, -33, -34}},
+		{5, 1, false, []int64{5}},
+	}
+
+	for _, tc := range tcs {
+		ms, err := New(tc.amount, GBP).Installments(tc.n, tc.remainderFirst)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, m := range ms {
+			if m.Amount() != tc.expected[i] {
+				t.Errorf("Expected installments of %d to be %v, installment %d got %d", tc.amount, tc.expected, i, m.Amount())
+			}
+		}
+	}
+
+	if _, err := New(100, GBP).Installments(0, true); err == nil {
+		t.Error("Expected error with zero installments")
+	}
+}
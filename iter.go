@@ -0,0 +1,96 @@
+//go:build go1.23
+// +build go1.23
+
+package money
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/shopspring/decimal"
+)
+
+// SplitSeq returns an iterator over the parts of Split(n), computing each part as it is consumed,
+// so that splitting into a large number of parts doesn't hold them all in memory. Like Split, it returns
+// ErrFractionalAmount under StrictWholeUnits when there are leftovers to distribute.
+func (m *Money) SplitSeq(n int) (iter.Seq[*Money], error) {
+	if n <= 0 {
+		return nil, ErrInvalidSplit
+	}
+
+	t := m.amount.Truncate(0)
+	if strictWholeUnits(m.currency) && (!t.Equal(m.amount) || !mutate.calc.modulus(t, int64(n)).IsZero()) {
+		return nil, fractionalError(m, fmt.Sprintf("split in %d", n))
+	}
+
+	a := mutate.calc.divide(t, int64(n)).Truncate(0)
+	r := mutate.calc.modulus(t, int64(n)).IntPart()
+
+	return func(yield func(*Money) bool) {
+		for p := 0; p < n; p++ {
+			amount := a
+			// Add leftovers to the first parties.
+			switch {
+			case int64(p) < r:
+				amount = mutate.calc.add(a, decimal.NewFromInt(1))
+			case int64(p) < -r:
+				amount = mutate.calc.add(a, decimal.NewFromInt(-1))
+			}
+
+			if !yield(&Money{amount: amount, currency: m.currency}) {
+				return
+			}
+		}
+	}, nil
+}
+
+// AllocateSeq returns an iterator over the parties of Allocate(rs...), computing each party as it is consumed.
+// Like Allocate, it returns ErrFractionalAmount under StrictWholeUnits when there are leftovers to distribute.
+func (m *Money) AllocateSeq(rs ...int) (iter.Seq[*Money], error) {
+	sum, err := ratioSum(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	t := m.amount.Truncate(0)
+	if strictWholeUnits(m.currency) && !t.Equal(m.amount) {
+		return nil, fractionalError(m, "allocation")
+	}
+
+	party := func(r int) Amount {
+		return mutate.calc.allocate(t, int64(r), sum).Truncate(0)
+	}
+
+	// if the sum of all ratios is zero, then every party is zero and there is no leftover
+	var leftover int64
+	if sum != 0 {
+		leftover = t.IntPart()
+		for _, r := range rs {
+			leftover -= party(r).IntPart()
+		}
+	}
+
+	if leftover != 0 && strictWholeUnits(m.currency) {
+		return nil, fractionalError(m, fmt.Sprintf("allocation by %v", rs))
+	}
+
+	return func(yield func(*Money) bool) {
+		lo := leftover
+		for _, r := range rs {
+			amount := party(r)
+			// Add leftovers to the first parties.
+			if lo != 0 {
+				sub := int64(1)
+				if lo < 0 {
+					sub = -sub
+				}
+				amount = mutate.calc.add(amount, decimal.NewFromInt(sub))
+				lo -= sub
+			}
+
+			if !yield(&Money{amount: amount, currency: m.currency}) {
+				return
+			}
+		}
+	}, nil
+}
@@ -0,0 +1,120 @@
+//go:build go1.23
+// +build go1.23
+
+package money
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMoney_SplitSeq(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		n      int
+	}{
+		{100, 3},
+		{-100, 3},
+		{5, 10},
+		{-5, 10},
+		{0, 4},
+		{99, 1},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		expected, err := m.Split(tc.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		seq, err := m.SplitSeq(tc.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// iterating twice gives the same parts
+		for i := 0; i < 2; i++ {
+			assertSameParts(t, expected, slices.Collect(seq))
+		}
+	}
+
+	if _, err := New(100, EUR).SplitSeq(0); err == nil {
+		t.Error("Expected an error for zero parts")
+	}
+}
+
+func TestMoney_AllocateSeq(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		rs     []int
+	}{
+		{100, []int{50, 50}},
+		{100, []int{30, 30, 30}},
+		{-100, []int{1, 1, 1}},
+		{5, []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{100, []int{0, 0}},
+		{200, []int{0, 1}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		expected, err := m.Allocate(tc.rs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		seq, err := m.AllocateSeq(tc.rs...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 2; i++ {
+			assertSameParts(t, expected, slices.Collect(seq))
+		}
+	}
+
+	if _, err := New(100, EUR).AllocateSeq(); err == nil {
+		t.Error("Expected an error for no ratios")
+	}
+
+	if _, err := New(100, EUR).AllocateSeq(1, -1); err == nil {
+		t.Error("Expected an error for negative ratios")
+	}
+}
+
+func TestMoney_SplitSeqBreak(t *testing.T) {
+	seq, err := New(1000000, EUR).SplitSeq(1000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	for m := range seq {
+		if m.Amount() != 1 {
+			t.Fatalf("Expected 1 got %d", m.Amount())
+		}
+
+		if n++; n == 3 {
+			break
+		}
+	}
+
+	if n != 3 {
+		t.Errorf("Expected to stop after 3 parts got %d", n)
+	}
+}
+
+func assertSameParts(t *testing.T, expected, got []*Money) {
+	t.Helper()
+
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d parts got %d", len(expected), len(got))
+	}
+
+	for i := range expected {
+		if got[i].Amount() != expected[i].Amount() || got[i].Currency() != expected[i].Currency() {
+			t.Errorf("Expected part %d to be %d got %d", i, expected[i].Amount(), got[i].Amount())
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownLocale happens when formatting for a locale that isn't registered.
+var ErrUnknownLocale = newError("money.unknown_locale", "unknown locale")
+
+// Locale stores the conventions used to display Money in a given locale, following CLDR data.
+// Templates use the same placeholders as Currency.Template: "1" for the amount and "$" for the currency grapheme.
+// NegativeTemplate is used for negative amounts in running text, AccountingTemplate in financial statements.
+type Locale struct {
+	Name               string
+	Decimal            string
+	Thousand           string
+	Template           string
+	NegativeTemplate   string
+	AccountingTemplate string
+}
+
+// localesMu guards locales, so locales can be added while Money is displayed.
+var localesMu sync.RWMutex
+
+// locales represents a collection of locales, keyed by name.
+var locales = map[string]*Locale{
+	"en-US": {Name: "en-US", Decimal: ".", Thousand: ",", Template: "$1", NegativeTemplate: "-$1", AccountingTemplate: "($1)"},
+	"en-GB": {Name: "en-GB", Decimal: ".", Thousand: ",", Template: "$1", NegativeTemplate: "-$1", AccountingTemplate: "($1)"},
+	"en-IE": {Name: "en-IE", Decimal: ".", Thousand: ",", Template: "$1", NegativeTemplate: "-$1", AccountingTemplate: "($1)"},
+	"de-DE": {Name: "de-DE", Decimal: ",", Thousand: ".", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "-1 $"},
+	"de-AT": {Name: "de-AT", Decimal: ",", Thousand: " ", Template: "$ 1", NegativeTemplate: "-$ 1", AccountingTemplate: "-$ 1"},
+	"de-CH": {Name: "de-CH", Decimal: ".", Thousand: "\u2019", Template: "$ 1", NegativeTemplate: "$-1", AccountingTemplate: "$-1"},
+	"es-ES": {Name: "es-ES", Decimal: ",", Thousand: ".", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "-1 $"},
+	"fr-FR": {Name: "fr-FR", Decimal: ",", Thousand: " ", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "(1 $)"},
+	"it-IT": {Name: "it-IT", Decimal: ",", Thousand: ".", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "-1 $"},
+	"ja-JP": {Name: "ja-JP", Decimal: ".", Thousand: ",", Template: "$1", NegativeTemplate: "-$1", AccountingTemplate: "($1)"},
+	"nl-NL": {Name: "nl-NL", Decimal: ",", Thousand: ".", Template: "$ 1", NegativeTemplate: "$ -1", AccountingTemplate: "($ 1)"},
+	"pl-PL": {Name: "pl-PL", Decimal: ",", Thousand: " ", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "(1 $)"},
+	"pt-BR": {Name: "pt-BR", Decimal: ",", Thousand: ".", Template: "$ 1", NegativeTemplate: "-$ 1", AccountingTemplate: "-$ 1"},
+	"pt-PT": {Name: "pt-PT", Decimal: ",", Thousand: " ", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "(1 $)"},
+	"sv-SE": {Name: "sv-SE", Decimal: ",", Thousand: " ", Template: "1 $", NegativeTemplate: "-1 $", AccountingTemplate: "-1 $"},
+}
+
+// AddLocale lets you insert or update a locale in the locales list. Its separators and templates are stored
+// in Unicode NFC. It is safe for concurrent use.
+func AddLocale(name, Decimal, Thousand, Template, NegativeTemplate, AccountingTemplate string) *Locale {
+	l := Locale{
+		Name:               name,
+		Decimal:            normalize(Decimal),
+		Thousand:           normalize(Thousand),
+		Template:           normalize(Template),
+		NegativeTemplate:   normalize(NegativeTemplate),
+		AccountingTemplate: normalize(AccountingTemplate),
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[name] = &l
+	return &l
+}
+
+// GetLocale returns the locale given its name, or nil if no such locale is registered.
+func GetLocale(name string) *Locale {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	return locales[name]
+}
+
+// Format returns Money formatted with the locale conventions, negative amounts using NegativeTemplate.
+func (l *Locale) Format(m *Money) string {
+	return l.format(m, l.NegativeTemplate)
+}
+
+// FormatAccounting returns Money formatted with the locale conventions, negative amounts using AccountingTemplate.
+func (l *Locale) FormatAccounting(m *Money) string {
+	return l.format(m, l.AccountingTemplate)
+}
+
+func (l *Locale) format(m *Money, negative string) string {
+	amount := m.amount.IntPart()
+
+	template := l.Template
+	if amount < 0 {
+		template = negative
+	}
+
+	// Format the absolute amount, which doesn't fit an int64 for math.MinInt64
+	f := Formatter{Fraction: m.currency.Fraction, Decimal: l.Decimal, Thousand: l.Thousand, Template: "1"}
+	s := strings.TrimPrefix(f.Format(amount), "-")
+	s = strings.Replace(template, "1", s, 1)
+	return strings.Replace(s, "$", m.currency.Grapheme, 1)
+}
+
+// DisplayIn lets represent Money struct as string using the conventions of the given locale, such as
+// "1.234,56 €" in de-DE or "1 234,56 €" in fr-FR.
+func (m *Money) DisplayIn(locale string) (string, error) {
+	l := GetLocale(locale)
+	if l == nil {
+		return "", fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+
+	return l.Format(m), nil
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestMoney_DisplayIn(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		locale   string
+		expected string
+	}{
+		{123456, EUR, "en-US", "€1,234.56"},
+		{123456, EUR, "de-DE", "1.234,56 €"},
+		{123456, EUR, "fr-FR", "1 234,56 €"},
+		{-123456, EUR, "fr-FR", "-1 234,56 €"},
+		{-123456, EUR, "nl-NL", "€ -1.234,56"},
+		{-123456, USD, "en-US", "-$1,234.56"},
+		{123456, CHF, "de-CH", "CHF 1\u2019234.56"},
+		{-5, CHF, "de-CH", "CHF-0.05"},
+		{123456, JPY, "ja-JP", "¥123,456"},
+		{123456, JPY, "de-DE", "123.456 ¥"},
+		{0, BRL, "pt-BR", "R$ 0,00"},
+		{math.MinInt64, USD, "en-US", "-$92,233,720,368,547,758.08"},
+		{math.MinInt64, EUR, "de-DE", "-92.233.720.368.547.758,08 €"},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, tc.code).DisplayIn(tc.locale)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+			continue
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %d %s in %s to be %q got %q", tc.amount, tc.code, tc.locale, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_DisplayInUnknownLocale(t *testing.T) {
+	_, err := New(100, EUR).DisplayIn("xx-XX")
+	if !errors.Is(err, ErrUnknownLocale) {
+		t.Errorf("Expected ErrUnknownLocale got %v", err)
+	}
+}
+
+func TestAddLocale_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			AddLocale(fmt.Sprintf("xx-%d", i), ",", ".", "1 $", "-1 $", "(1 $)")
+		}(i)
+		go func() {
+			defer wg.Done()
+			if _, err := New(123456, EUR).DisplayIn("de-DE"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if GetLocale("xx-7") == nil {
+		t.Error("Expected the locales added concurrently to be registered")
+	}
+}
+
+func TestLocale_FormatAccounting(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		locale   string
+		expected string
+	}{
+		{-123456, "en-US", "($1,234.56)"},
+		{123456, "en-US", "$1,234.56"},
+		{-123456, "fr-FR", "(1 234,56 $)"},
+		{-123456, "de-DE", "-1.234,56 $"},
+	}
+
+	for _, tc := range tcs {
+		r := GetLocale(tc.locale).FormatAccounting(New(tc.amount, USD))
+
+		if r != tc.expected {
+			t.Errorf("Expected %d in %s to be %q got %q", tc.amount, tc.locale, tc.expected, r)
+		}
+	}
+}
+
+func TestAddLocale(t *testing.T) {
+	AddLocale("xx-TEST", ",", "_", "1$", "1-$", "<1$>")
+	l := GetLocale("xx-TEST")
+
+	if r := l.Format(New(-123456, EUR)); r != "1_234,56-€" {
+		t.Errorf("Expected 1_234,56-€ got %s", r)
+	}
+
+	if r := l.FormatAccounting(New(-123456, EUR)); r != "<1_234,56€>" {
+		t.Errorf("Expected <1_234,56€> got %s", r)
+	}
+}
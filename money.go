@@ -3,8 +3,8 @@ package money
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io"
 	"math"
 
 	"github.com/shopspring/decimal"
@@ -22,25 +22,58 @@ var (
 	MarshalJSON = defaultMarshalJSON
 
 	// ErrCurrencyMismatch happens when two compared Money don't have the same currency.
-	ErrCurrencyMismatch = errors.New("currencies don't match")
+	ErrCurrencyMismatch = newError("money.currency_mismatch", "currencies don't match")
+
+	// ErrDivisionByZero happens when dividing Money by zero.
+	ErrDivisionByZero = newError("money.division_by_zero", "division by zero")
 
 	// ErrInvalidJSONUnmarshal happens when the default money.UnmarshalJSON fails to unmarshal Money because of invalid data.
-	ErrInvalidJSONUnmarshal = errors.New("invalid json unmarshal")
+	ErrInvalidJSONUnmarshal = newError("money.invalid_json", "invalid json unmarshal")
+
+	// ErrNoDivisor happens when dividing Money without any divisor.
+	ErrNoDivisor = newError("money.no_divisor", "at least one divisor is required to divide")
+
+	// ErrInvalidSplit happens when splitting Money into less than one part.
+	ErrInvalidSplit = newError("money.invalid_split", "split must be higher than zero")
+
+	// ErrNoRatios happens when allocating Money without any ratio.
+	ErrNoRatios = newError("money.no_ratios", "no ratios specified")
+
+	// ErrNegativeRatio happens when allocating Money with a negative ratio.
+	ErrNegativeRatio = newError("money.negative_ratio", "negative ratios not allowed")
+
+	// ErrRatiosOverflow happens when the allocation ratios sum to more than an int64 holds.
+	ErrRatiosOverflow = newError("money.ratios_overflow", "sum of given ratios exceeds max int")
 )
 
 func defaultUnmarshalJSON(m *Money, b []byte) error {
 	data := make(map[string]interface{})
-	err := json.Unmarshal(b, &data)
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	err := d.Decode(&data)
 	if err != nil {
 		return err
 	}
+	if _, err := d.Token(); err != io.EOF {
+		return ErrInvalidJSONUnmarshal
+	}
 
-	var amount float64
+	var amount int64
 	if amountRaw, ok := data["amount"]; ok {
-		amount, ok = amountRaw.(float64)
+		n, ok := amountRaw.(json.Number)
 		if !ok {
 			return ErrInvalidJSONUnmarshal
 		}
+
+		if amount, err = jsonAmount(n); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := data["version"]; ok {
+		if n, ok := v.(json.Number); !ok || !validVersion(n) {
+			return ErrInvalidJSONUnmarshal
+		}
 	}
 
 	var currency string
@@ -55,18 +88,86 @@ func defaultUnmarshalJSON(m *Money, b []byte) error {
 	if amount == 0 && currency == "" {
 		ref = &Money{}
 	} else {
-		ref = New(int64(amount), currency)
+		ref = New(amount, currency)
 	}
 
 	*m = *ref
 	return nil
 }
 
+// JSONAmountError happens when the default money.UnmarshalJSON gets an amount that isn't a whole number
+// of minor units fitting an int64, such as 100.5, typically major units sent in the minor-unit amount field.
+// It wraps ErrInvalidJSONUnmarshal.
+type JSONAmountError struct {
+	Amount   string
+	Overflow bool
+}
+
+// Error implements error.
+func (e *JSONAmountError) Error() string {
+	if e.Overflow {
+		return fmt.Sprintf("json amount %s overflows int64", e.Amount)
+	}
+
+	return fmt.Sprintf("json amount %s is not a whole number of minor units", e.Amount)
+}
+
+// Unwrap returns ErrInvalidJSONUnmarshal, so that errors.Is(err, ErrInvalidJSONUnmarshal) holds for amount errors.
+func (e *JSONAmountError) Unwrap() error {
+	return ErrInvalidJSONUnmarshal
+}
+
+// ErrorCode implements CodedError.
+func (e *JSONAmountError) ErrorCode() string {
+	return "money.invalid_json_amount"
+}
+
+// jsonAmount returns the JSON number as minor units, rejecting fractions and values beyond int64
+// with a *JSONAmountError instead of coercing them.
+func jsonAmount(n json.Number) (int64, error) {
+	if i, err := n.Int64(); err == nil {
+		return i, nil
+	}
+
+	d, err := decimal.NewFromString(n.String())
+	if err != nil {
+		return 0, ErrInvalidJSONUnmarshal
+	}
+
+	if d.IsZero() {
+		return 0, nil
+	}
+
+	if !d.IsInteger() {
+		return 0, &JSONAmountError{Amount: n.String()}
+	}
+
+	// An int64 has 19 digits, so larger exponents overflow without expanding the coefficient.
+	if d.Exponent() > 18 || !d.BigInt().IsInt64() {
+		return 0, &JSONAmountError{Amount: n.String(), Overflow: true}
+	}
+
+	return d.IntPart(), nil
+}
+
+// validVersion reports whether n is a version of the JSON encoding: a positive integer. Versions after
+// CanonicalJSONVersion are accepted, as they only add fields.
+func validVersion(n json.Number) bool {
+	v, err := n.Int64()
+	return err == nil && v > 0
+}
+
+// defaultMarshalJSON writes the amount in minor units, returning ErrLossyConversion for Money carrying precision
+// beyond the currency fraction rather than dropping it.
 func defaultMarshalJSON(m Money) ([]byte, error) {
 	if m == (Money{}) {
 		m = *New(0, "")
 	}
 
+	if !m.amount.IsInteger() {
+		return nil, ErrLossyConversion
+	}
+
 	buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %d, "currency": "%s"}`, m.Amount(), m.Currency().Code))
 	return buff.Bytes(), nil
 }
@@ -89,6 +190,13 @@ func New(amount int64, code string) *Money {
 	}
 }
 
+// NewFromNumericCode creates and returns new instance of Money in the currency with the given ISO 4217
+// numeric code, such as "840" for USD, as used by payment networks. It returns ErrUnknownCurrency when
+// no registered currency has the code.
+func NewFromNumericCode(amount int64, code string) (*Money, error) {
+	return DefaultRegistry.NewFromNumericCode(amount, code)
+}
+
 // NewFromFloat creates and returns new instance of Money from a float64.
 // Always rounding trailing decimals down.
 func NewFromFloat(amount float64, code string) *Money {
@@ -98,16 +206,43 @@ func NewFromFloat(amount float64, code string) *Money {
 	return New(amt.IntPart(), code)
 }
 
-// Currency returns the currency used by Money.
+// NewFromDecimal creates and returns new instance of Money from a decimal amount of major units,
+// e.g. 3.199 for a fuel price of $3.199 per unit. Unlike NewFromFloat, the amount is kept exactly, including any
+// precision beyond the currency fraction; use RoundToCurrency to snap it back to whole minor units.
+func NewFromDecimal(amount decimal.Decimal, code string) *Money {
+	currency := newCurrency(code).get()
+	return &Money{
+		amount:   amount.Shift(int32(currency.Fraction)),
+		currency: currency,
+	}
+}
+
+// NewFromString creates and returns new instance of Money from a string holding an exact amount of major units,
+// such as "12.34" or "-0.125", as received from APIs and user forms. The amount uses a dot as decimal separator,
+// without thousand separators or exponent. Garbage input is rejected with ErrInvalidAmount. An amount with more
+// decimal places than the currency allows is rejected with a *PrecisionError, unless a rounding mode is given
+// to round it to whole minor units.
+func NewFromString(s, code string, mode ...RoundingMode) (*Money, error) {
+	return DefaultRegistry.NewFromString(s, code, mode...)
+}
+
+// Currency returns the currency used by Money. All Money in a currency share the same instance,
+// so it must be treated as read-only; use AddCurrency to change how a currency behaves.
 func (m *Money) Currency() *Currency {
 	return m.currency
 }
 
-// Amount returns a copy of the internal monetary value as an int64.
+// Amount returns a copy of the internal monetary value as an int64 of minor units,
+// truncating any precision beyond the currency fraction.
 func (m *Money) Amount() int64 {
 	return m.amount.IntPart()
 }
 
+// Decimal returns the exact monetary value in major units, including any precision beyond the currency fraction.
+func (m *Money) Decimal() decimal.Decimal {
+	return m.amount.Shift(-int32(m.currency.Fraction))
+}
+
 // SameCurrency check if given Money is equals by currency.
 func (m *Money) SameCurrency(om *Money) bool {
 	return m.currency.equals(om.currency)
@@ -122,6 +257,13 @@ func (m *Money) assertSameCurrency(om *Money) error {
 }
 
 func (m *Money) compare(om *Money) int {
+	// amounts of the same exponent already compare without allocating
+	if m.amount.Exponent() != om.amount.Exponent() {
+		if x, y, _, ok := fixedPair(m.amount, om.amount); ok {
+			return x.cmp(y)
+		}
+	}
+
 	switch {
 	case m.amount.GreaterThan(om.amount):
 		return 1
@@ -208,17 +350,30 @@ func (m *Money) Add(ms ...*Money) (*Money, error) {
 		return m, nil
 	}
 
-	k := New(0, m.currency.Code)
-
-	for _, m2 := range ms {
+	k := ms[0].amount
+	for i, m2 := range ms {
 		if err := m.assertSameCurrency(m2); err != nil {
 			return nil, err
 		}
 
-		k.amount = mutate.calc.add(k.amount, m2.amount)
+		if i > 0 {
+			k = mutate.calc.add(k, m2.amount)
+		}
 	}
 
-	return &Money{amount: mutate.calc.add(m.amount, k.amount), currency: m.currency}, nil
+	return &Money{amount: mutate.calc.add(m.amount, k), currency: m.currency}, nil
+}
+
+// SmallestUnit returns new Money struct with value representing one minor unit of the currency of Self,
+// e.g. €0.01 for EUR and ¥1 for JPY.
+func (m *Money) SmallestUnit() *Money {
+	return &Money{amount: decimal.NewFromInt(1), currency: m.currency}
+}
+
+// AddSmallestUnits returns new Money struct with value representing Self plus n minor units of its currency,
+// e.g. AddSmallestUnits(-1) takes a penny off a EUR amount to correct a rounding difference.
+func (m *Money) AddSmallestUnits(n int64) *Money {
+	return &Money{amount: mutate.calc.add(m.amount, decimal.NewFromInt(n)), currency: m.currency}
 }
 
 // Subtract returns new Money struct with value representing difference of Self and Other Money.
@@ -227,17 +382,18 @@ func (m *Money) Subtract(ms ...*Money) (*Money, error) {
 		return m, nil
 	}
 
-	k := New(0, m.currency.Code)
-
-	for _, m2 := range ms {
+	k := ms[0].amount
+	for i, m2 := range ms {
 		if err := m.assertSameCurrency(m2); err != nil {
 			return nil, err
 		}
 
-		k.amount = mutate.calc.add(k.amount, m2.amount)
+		if i > 0 {
+			k = mutate.calc.add(k, m2.amount)
+		}
 	}
 
-	return &Money{amount: mutate.calc.subtract(m.amount, k.amount), currency: m.currency}, nil
+	return &Money{amount: mutate.calc.subtract(m.amount, k), currency: m.currency}, nil
 }
 
 // Multiply returns new Money struct with value representing Self multiplied value by multiplier.
@@ -246,41 +402,136 @@ func (m *Money) Multiply(muls ...int64) *Money {
 		panic("At least one multiplier is required to multiply")
 	}
 
-	k := New(1, m.currency.Code)
-
+	k := m.amount
 	for _, m2 := range muls {
-		k.amount = mutate.calc.multiply(k.amount, m2)
+		k = mutate.calc.multiply(k, m2)
+	}
+
+	return &Money{amount: k, currency: m.currency}
+}
+
+// AddValue returns Money representing the sum of Self and Other Money. It is the value counterpart of Add:
+// it neither takes nor returns pointers, so hot loops don't allocate Money on the heap.
+func (m Money) AddValue(om Money) (Money, error) {
+	if err := m.assertSameCurrency(&om); err != nil {
+		return Money{}, err
 	}
 
-	return &Money{amount: mutate.calc.multiply(m.amount, k.amount.IntPart()), currency: m.currency}
+	return Money{amount: mutate.calc.add(m.amount, om.amount), currency: m.currency}, nil
 }
 
-// Round returns new Money struct with value rounded to nearest zero.
-func (m *Money) Round() *Money {
-	return &Money{amount: mutate.calc.round(m.amount, m.currency.Fraction), currency: m.currency}
+// SubtractValue returns Money representing the difference of Self and Other Money, the value counterpart of Subtract.
+func (m Money) SubtractValue(om Money) (Money, error) {
+	if err := m.assertSameCurrency(&om); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: mutate.calc.subtract(m.amount, om.amount), currency: m.currency}, nil
+}
+
+// MultiplyValue returns Money representing Self multiplied by the multiplier, the value counterpart of Multiply.
+func (m Money) MultiplyValue(mul int64) Money {
+	return Money{amount: mutate.calc.multiply(m.amount, mul), currency: m.currency}
+}
+
+// MultiplyDecimal returns new Money struct with value representing Self multiplied by a decimal multiplier.
+// The result is exact and may carry precision beyond the currency fraction.
+func (m *Money) MultiplyDecimal(mul decimal.Decimal) *Money {
+	return &Money{amount: mutate.calc.multiplyDecimal(m.amount, mul), currency: m.currency}
+}
+
+// Divide returns new Money struct with value representing Self divided by the divisors,
+// rounded half away from zero to whole minor units. See StrictWholeUnits to forbid the rounding
+// for currencies without minor units.
+func (m *Money) Divide(divisors ...int64) (*Money, error) {
+	d, err := divisorProduct(divisors)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.DivideDecimal(d)
+}
+
+// DivideWithMode returns new Money struct with value representing Self divided by the divisors,
+// rounded to whole minor units using the given rounding mode. The value is rounded once, after all divisions.
+func (m *Money) DivideWithMode(mode RoundingMode, divisors ...int64) (*Money, error) {
+	d, err := divisorProduct(divisors)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.DivideDecimal(d, mode)
+}
+
+// divisorProduct returns the product of the divisors, which must be given.
+func divisorProduct(divisors []int64) (decimal.Decimal, error) {
+	if len(divisors) == 0 {
+		return decimal.Decimal{}, ErrNoDivisor
+	}
+
+	d := decimal.NewFromInt(1)
+	for _, v := range divisors {
+		d = mutate.calc.multiply(d, v)
+	}
+
+	return d, nil
+}
+
+// DivideDecimal returns new Money struct with value representing Self divided by a decimal divisor,
+// rounded to whole minor units using the given rounding mode, half away from zero by default.
+func (m *Money) DivideDecimal(divisor decimal.Decimal, mode ...RoundingMode) (*Money, error) {
+	if divisor.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	if len(mode) == 0 && strictWholeUnits(m.currency) {
+		if _, r := mutate.calc.quoRem(m.amount, divisor); !r.IsZero() {
+			return nil, fractionalError(m, "division by "+divisor.String())
+		}
+	}
+
+	return &Money{amount: mutate.calc.divideRound(m.amount, divisor, roundingMode(mode)), currency: m.currency}, nil
+}
+
+// Round returns new Money struct with value rounded to whole major units,
+// using the given rounding mode, half away from zero by default.
+func (m *Money) Round(mode ...RoundingMode) *Money {
+	return &Money{amount: mutate.calc.round(m.amount, m.currency.Fraction, roundingMode(mode)), currency: m.currency}
+}
+
+// RoundToCurrency returns new Money struct with value rounded to whole minor units of its currency,
+// dropping any precision beyond the currency fraction, using the given rounding mode, half away from zero by default.
+func (m *Money) RoundToCurrency(mode ...RoundingMode) *Money {
+	return &Money{amount: mutate.calc.round(m.amount, 0, roundingMode(mode)), currency: m.currency}
 }
 
 // Split returns slice of Money structs with split Self value in given number.
 // After division leftover pennies will be distributed round-robin amongst the parties.
 // This means that parties listed first will likely receive more pennies than ones that are listed later.
+// Only whole minor units are split; any precision beyond the currency fraction is truncated first.
 func (m *Money) Split(n int) ([]*Money, error) {
 	if n <= 0 {
-		return nil, errors.New("split must be higher than zero")
+		return nil, ErrInvalidSplit
 	}
 
-	a := mutate.calc.divide(m.amount, int64(n))
+	t := m.amount.Truncate(0)
+	if strictWholeUnits(m.currency) && (!t.Equal(m.amount) || !mutate.calc.modulus(t, int64(n)).IsZero()) {
+		return nil, fractionalError(m, fmt.Sprintf("split in %d", n))
+	}
+
+	a := mutate.calc.divide(t, int64(n)).Truncate(0)
 	ms := make([]*Money, n)
 
 	for i := 0; i < n; i++ {
 		ms[i] = &Money{amount: a, currency: m.currency}
 	}
 
-	r := mutate.calc.modulus(m.amount, int64(n))
+	r := mutate.calc.modulus(t, int64(n))
 	l := mutate.calc.absolute(r)
 	// Add leftovers to the first parties.
 
 	v := int64(1)
-	if m.amount.IsNegative() {
+	if t.IsNegative() {
 		v = -1
 	}
 	for p := 0; !l.IsZero(); p++ {
@@ -294,28 +545,23 @@ func (m *Money) Split(n int) ([]*Money, error) {
 // Allocate returns slice of Money structs with split Self value in given ratios.
 // It lets split money by given ratios without losing pennies and as Split operations distributes
 // leftover pennies amongst the parties with round-robin principle.
+// Only whole minor units are allocated; any precision beyond the currency fraction is truncated first.
 func (m *Money) Allocate(rs ...int) ([]*Money, error) {
-	if len(rs) == 0 {
-		return nil, errors.New("no ratios specified")
+	sum, err := ratioSum(rs)
+	if err != nil {
+		return nil, err
 	}
 
-	// Calculate sum of ratios.
-	var sum int64
-	for _, r := range rs {
-		if r < 0 {
-			return nil, errors.New("negative ratios not allowed")
-		}
-		if int64(r) > (math.MaxInt64 - sum) {
-			return nil, errors.New("sum of given ratios exceeds max int")
-		}
-		sum += int64(r)
+	t := m.amount.Truncate(0)
+	if strictWholeUnits(m.currency) && !t.Equal(m.amount) {
+		return nil, fractionalError(m, "allocation")
 	}
 
 	var total int64
 	ms := make([]*Money, 0, len(rs))
 	for _, r := range rs {
 		party := &Money{
-			amount:   mutate.calc.allocate(m.amount, int64(r), sum),
+			amount:   mutate.calc.allocate(t, int64(r), sum).Truncate(0),
 			currency: m.currency,
 		}
 
@@ -330,7 +576,11 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 	}
 
 	// Calculate leftover value and divide to first parties.
-	lo := m.amount.IntPart() - total
+	lo := t.IntPart() - total
+	if lo != 0 && strictWholeUnits(m.currency) {
+		return nil, fractionalError(m, fmt.Sprintf("allocation by %v", rs))
+	}
+
 	sub := int64(1)
 	if lo < 0 {
 		sub = -sub
@@ -344,16 +594,47 @@ func (m *Money) Allocate(rs ...int) ([]*Money, error) {
 	return ms, nil
 }
 
+// ratioSum returns the sum of allocation ratios, which must be given, not negative and not overflow.
+func ratioSum(rs []int) (int64, error) {
+	if len(rs) == 0 {
+		return 0, ErrNoRatios
+	}
+
+	var sum int64
+	for _, r := range rs {
+		if r < 0 {
+			return 0, ErrNegativeRatio
+		}
+		if int64(r) > (math.MaxInt64 - sum) {
+			return 0, ErrRatiosOverflow
+		}
+		sum += int64(r)
+	}
+
+	return sum, nil
+}
+
 // Display lets represent Money struct as string in given Currency value.
 func (m *Money) Display() string {
-	c := m.currency.get()
-	return c.Formatter().Format(m.amount.IntPart())
+	return m.currency.Formatter().Format(m.amount.IntPart())
 }
 
 // AsMajorUnits lets represent Money struct as subunits (float64) in given Currency value
 func (m *Money) AsMajorUnits() float64 {
-	c := m.currency.get()
-	return c.Formatter().ToMajorUnits(m.amount.IntPart())
+	return m.currency.Formatter().ToMajorUnits(m.amount.IntPart())
+}
+
+// MachineString returns the amount of Money in major units without symbol or thousand separators,
+// using a dot as decimal separator and exactly as many decimals as the currency fraction, e.g. "1234.56" or "-5".
+// Money carrying precision beyond the currency fraction is written with the extra decimals, e.g. "3.199".
+// Unlike Display it doesn't depend on the currency or locale conventions, which makes it the format to use in
+// logs, URLs and query parameters.
+func (m *Money) MachineString() string {
+	if !m.amount.IsInteger() {
+		return m.amount.Shift(-int32(m.currency.Fraction)).String()
+	}
+
+	return NewFormatter(m.currency.Fraction, ".", "", "", "1").Format(m.amount.IntPart())
 }
 
 // UnmarshalJSON is implementation of json.Unmarshaller
@@ -380,3 +661,14 @@ func (m *Money) Compare(om *Money) (int, error) {
 
 	return m.compare(om), nil
 }
+
+// CompareAmount compares the amounts of two Money in major units, ignoring their currencies:
+// 1 EUR and 1 JPY compare equal. It is unit-unsafe and meant for analytics that bucket amounts by
+// magnitude across currencies; use Compare to compare Money.
+//
+//	if m > om returns 1
+//	if m == om returns 0
+//	if m < om returns -1
+func (m *Money) CompareAmount(om *Money) int {
+	return m.Decimal().Cmp(om.Decimal())
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -53,8 +54,11 @@ func TestNew_WithUnregisteredCurrency(t *testing.T) {
 func TestCurrency(t *testing.T) {
 	code := "MOCK"
 	decimals := 5
-	AddCurrency(code, "M$", "1 $", ".", ",", decimals)
-	m := New(1, code)
+	r := NewRegistry()
+	if _, err := r.AddCurrency(code, "M$", "1 $", ".", ",", decimals); err != nil {
+		t.Fatal(err)
+	}
+	m := r.New(1, code)
 	c := m.Currency().Code
 	if c != code {
 		t.Errorf("Expected %s got %s", code, c)
@@ -541,9 +545,13 @@ func TestMoney_RoundWithExponential(t *testing.T) {
 		{12555, 13000},
 	}
 
+	r := NewRegistry()
+	if _, err := r.AddCurrency("CUR", "*", "$1", ".", ",", 3); err != nil {
+		t.Fatal(err)
+	}
+
 	for _, tc := range tcs {
-		AddCurrency("CUR", "*", "$1", ".", ",", 3)
-		m := New(tc.amount, "CUR")
+		m := r.New(tc.amount, "CUR")
 		r := m.Round().amount
 
 		if !r.Equal(decimal.NewFromInt(tc.expected)) {
@@ -683,6 +691,52 @@ func TestMoney_Display(t *testing.T) {
 	}
 }
 
+func TestMoney_MachineString(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456, EUR, "1234.56"},
+		{123456, BRL, "1234.56"},
+		{-123456, CHF, "-1234.56"},
+		{1, USD, "0.01"},
+		{0, USD, "0.00"},
+		{1234567, JPY, "1234567"},
+		{1234567, BHD, "1234.567"},
+		{100, "XYZ", "1.00"},
+		{math.MinInt64, EUR, "-92233720368547758.08"},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		r := m.MachineString()
+
+		if r != tc.expected {
+			t.Errorf("Expected machine string of %d %s to be %s got %s", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+
+	m := &Money{amount: decimal.RequireFromString("-319.9"), currency: newCurrency(EUR).get()}
+	if r := m.MachineString(); r != "-3.199" {
+		t.Errorf("Expected the sub-minor machine string %s got %s", "-3.199", r)
+	}
+}
+
+func TestMoney_MachineStringAllCurrencies(t *testing.T) {
+	for code, c := range currencies {
+		s := New(-123456789, code).MachineString()
+		if strings.ContainsAny(s, ", '") || (c.Fraction > 0 && strings.Count(s, ".") != 1) {
+			t.Errorf("Expected %s machine string without grouping, got %s", code, s)
+		}
+
+		a, err := parseAmount(s, numberFormat{decimal: "."}, code, c.Fraction)
+		if err != nil || a.IntPart() != -123456789 {
+			t.Errorf("Expected %s machine string %s to parse back, got %v %v", code, s, a, err)
+		}
+	}
+}
+
 func TestMoney_AsMajorUnits(t *testing.T) {
 	tcs := []struct {
 		amount   int64
@@ -779,6 +833,26 @@ func TestMoney_Comparison(t *testing.T) {
 	}
 }
 
+func TestMoney_CompareAmount(t *testing.T) {
+	tcs := []struct {
+		m, om    *Money
+		expected int
+	}{
+		{New(100, EUR), New(1, JPY), 0},
+		{New(100, EUR), New(100, JPY), -1},
+		{New(1000, KWD), New(100, EUR), 0},
+		{New(1001, KWD), New(100, EUR), 1},
+		{NewFromDecimal(decimal.RequireFromString("1.005"), EUR), New(100, USD), 1},
+		{New(-100, GBP), New(-1, JPY), 0},
+	}
+
+	for _, tc := range tcs {
+		if r := tc.m.CompareAmount(tc.om); r != tc.expected {
+			t.Errorf("Expected %s compared to %s == %d got %d", tc.m.Decimal(), tc.om.Decimal(), tc.expected, r)
+		}
+	}
+}
+
 func TestMoney_Currency(t *testing.T) {
 	pound := New(100, GBP)
 
@@ -869,6 +943,19 @@ func TestDefaultMarshal(t *testing.T) {
 	}
 }
 
+func TestDefaultMarshal_SubMinorUnits(t *testing.T) {
+	MarshalJSON = defaultMarshalJSON
+	m := &Money{amount: decimal.RequireFromString("319.9"), currency: newCurrency(EUR).get()}
+
+	if _, err := json.Marshal(m); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, err)
+	}
+
+	if _, err := JSONDBValue(*m); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, err)
+	}
+}
+
 func TestCustomMarshal(t *testing.T) {
 	given := New(12345, IQD)
 	expected := `{"amount":12345,"currency_code":"IQD","currency_fraction":3}`
@@ -931,6 +1018,49 @@ func TestDefaultUnmarshal(t *testing.T) {
 	if !errors.Is(err, ErrInvalidJSONUnmarshal) {
 		t.Errorf("Expected ErrInvalidJSONUnmarshal, got %+v", err)
 	}
+
+	given = `{"amount": 1e3, "currency": "USD"}`
+	err = json.Unmarshal([]byte(given), &m)
+	if err != nil || m.Amount() != 1000 {
+		t.Errorf("Expected %d got %d, %v", 1000, m.Amount(), err)
+	}
+}
+
+func TestDefaultUnmarshalJSON_Amount(t *testing.T) {
+	tcs := []struct {
+		given    string
+		overflow bool
+	}{
+		{`{"amount": 100.5, "currency": "USD"}`, false},
+		{`{"amount": -0.01, "currency": "USD"}`, false},
+		{`{"amount": 9223372036854775808, "currency": "USD"}`, true},
+		{`{"amount": -9223372036854775809, "currency": "USD"}`, true},
+		{`{"amount": 1e400, "currency": "USD"}`, true},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		err := defaultUnmarshalJSON(&m, []byte(tc.given))
+
+		var ae *JSONAmountError
+		if !errors.As(err, &ae) || ae.Overflow != tc.overflow {
+			t.Errorf("Expected a *JSONAmountError for %s got %v", tc.given, err)
+		}
+		if !errors.Is(err, ErrInvalidJSONUnmarshal) || ErrorCode(err) != "money.invalid_json_amount" {
+			t.Errorf("Expected the error to wrap ErrInvalidJSONUnmarshal got %v", err)
+		}
+		if m != (Money{}) {
+			t.Errorf("Expected Money to be left unchanged got %+v", m)
+		}
+	}
+
+	var m Money
+	if err := defaultUnmarshalJSON(&m, []byte(`{"amount": 9223372036854775807, "currency": "USD"}`)); err != nil || m.Amount() != math.MaxInt64 {
+		t.Errorf("Expected %d got %d, %v", int64(math.MaxInt64), m.Amount(), err)
+	}
+	if err := defaultUnmarshalJSON(&m, []byte(`{"amount": 1}{}`)); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected ErrInvalidJSONUnmarshal for trailing data got %v", err)
+	}
 }
 
 func TestCustomUnmarshal(t *testing.T) {
@@ -957,3 +1087,459 @@ func TestCustomUnmarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, m.Display())
 	}
 }
+
+func TestNewFromDecimal(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+		minor    int64
+	}{
+		{"3.199", USD, "3.199", 319},
+		{"-0.125", EUR, "-0.125", -12},
+		{"12", JPY, "12", 12},
+		{"1.0005", BHD, "1.0005", 1000},
+	}
+
+	for _, tc := range tcs {
+		m := NewFromDecimal(decimal.RequireFromString(tc.amount), tc.code)
+
+		if !m.Decimal().Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected decimal %s got %s", tc.expected, m.Decimal())
+		}
+
+		if m.Amount() != tc.minor {
+			t.Errorf("Expected amount %d got %d", tc.minor, m.Amount())
+		}
+
+		if m.Currency().Code != tc.code {
+			t.Errorf("Expected currency %s got %s", tc.code, m.Currency().Code)
+		}
+	}
+}
+
+func TestNewFromString(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		mode     []RoundingMode
+		expected int64
+	}{
+		{"12.34", EUR, nil, 1234},
+		{"-12.3", EUR, nil, -1230},
+		{"+5", USD, nil, 500},
+		{" 0.07 ", USD, nil, 7},
+		{"1234", JPY, nil, 1234},
+		{"1.234", BHD, nil, 1234},
+		{"-0.125", EUR, []RoundingMode{RoundHalfUp}, -13},
+		{"-0.125", EUR, []RoundingMode{RoundHalfEven}, -12},
+		{"-0.125", EUR, []RoundingMode{RoundTruncate}, -12},
+		{"12.5", JPY, []RoundingMode{RoundCeiling}, 13},
+		{"92233720368547758.07", EUR, nil, math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromString(tc.amount, tc.code, tc.mode...)
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", tc.amount, err)
+			continue
+		}
+
+		if m.Amount() != tc.expected || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to be %d %s got %d %s", tc.amount, tc.expected, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestNewFromString_Errors(t *testing.T) {
+	tcs := []struct {
+		amount string
+		code   string
+	}{
+		{"", EUR},
+		{"abc", EUR},
+		{"12.34.56", EUR},
+		{"1,234.56", EUR},
+		{"1e3", EUR},
+		{"0x10", EUR},
+		{"--1", EUR},
+		{"NaN", EUR},
+	}
+
+	for _, tc := range tcs {
+		if m, err := NewFromString(tc.amount, tc.code); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected %q to fail with %v got %v %v", tc.amount, ErrInvalidAmount, m, err)
+		}
+	}
+
+	_, err := NewFromString("-0.125", EUR)
+	var perr *PrecisionError
+	if !errors.As(err, &perr) || perr.Code != EUR || perr.Amount != "-0.125" {
+		t.Errorf("Expected a precision error got %v", err)
+	}
+}
+
+func TestMoney_Decimal(t *testing.T) {
+	m := New(123456, EUR)
+	if !m.Decimal().Equal(decimal.RequireFromString("1234.56")) {
+		t.Errorf("Expected 1234.56 got %s", m.Decimal())
+	}
+}
+
+func TestMoney_MultiplyDecimal(t *testing.T) {
+	price := NewFromDecimal(decimal.RequireFromString("3.199"), USD)
+	r := price.MultiplyDecimal(decimal.RequireFromString("42.5"))
+
+	if !r.Decimal().Equal(decimal.RequireFromString("135.9575")) {
+		t.Errorf("Expected 135.9575 got %s", r.Decimal())
+	}
+
+	sum, err := r.Add(NewFromDecimal(decimal.RequireFromString("0.0025"), USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !sum.Decimal().Equal(decimal.RequireFromString("135.96")) {
+		t.Errorf("Expected sub-cent precision to be kept by Add, got %s", sum.Decimal())
+	}
+}
+
+func TestMoney_RoundToCurrency(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		expected int64
+	}{
+		{"3.199", 320},
+		{"3.195", 320},
+		{"3.194", 319},
+		{"-3.195", -320},
+		{"3.19", 319},
+	}
+
+	for _, tc := range tcs {
+		r := NewFromDecimal(decimal.RequireFromString(tc.amount), USD).RoundToCurrency()
+
+		if !r.amount.Equal(decimal.NewFromInt(tc.expected)) {
+			t.Errorf("Expected %s to round to %d got %s", tc.amount, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_SplitAllocateWholeMinorUnits(t *testing.T) {
+	m := NewFromDecimal(decimal.RequireFromString("1.009"), EUR)
+
+	parties, err := m.Split(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range []int64{34, 33, 33} {
+		if !parties[i].amount.Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("Expected split party %d to be %d got %s", i, expected, parties[i].amount)
+		}
+	}
+
+	parties, err = m.Allocate(1, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, expected := range []int64{34, 33, 33} {
+		if !parties[i].amount.Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("Expected allocated party %d to be %d got %s", i, expected, parties[i].amount)
+		}
+	}
+}
+
+func TestMoney_RoundWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{250, RoundHalfUp, 300},
+		{250, RoundHalfEven, 200},
+		{350, RoundHalfEven, 400},
+		{250, RoundHalfDown, 200},
+		{201, RoundCeiling, 300},
+		{299, RoundFloor, 200},
+		{-201, RoundFloor, -300},
+		{-299, RoundTruncate, -200},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, EUR).Round(tc.mode).amount
+
+		if !r.Equal(decimal.NewFromInt(tc.expected)) {
+			t.Errorf("Expected %d rounded %s to be %d got %s", tc.amount, tc.mode, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_Divide(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		divisors []int64
+		expected int64
+	}{
+		{100, []int64{3}, 33},
+		{200, []int64{3}, 67},
+		{-200, []int64{3}, -67},
+		{1000, []int64{2, 5}, 100},
+		{5, []int64{2}, 3},
+		{5, []int64{-2}, -3},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).Divide(tc.divisors...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !r.amount.Equal(decimal.NewFromInt(tc.expected)) {
+			t.Errorf("Expected %d / %v = %d got %s", tc.amount, tc.divisors, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_DivideWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		mode     RoundingMode
+		expected int64
+	}{
+		{5, RoundHalfUp, 3},
+		{5, RoundHalfEven, 2},
+		{7, RoundHalfEven, 4},
+		{-5, RoundHalfEven, -2},
+		{5, RoundFloor, 2},
+		{-5, RoundFloor, -3},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, EUR).DivideWithMode(tc.mode, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !r.amount.Equal(decimal.NewFromInt(tc.expected)) {
+			t.Errorf("Expected %d / 2 rounded %s = %d got %s", tc.amount, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_DivideDecimal(t *testing.T) {
+	r, err := New(1000, EUR).DivideDecimal(decimal.RequireFromString("1.19"), RoundHalfEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.amount.Equal(decimal.NewFromInt(840)) {
+		t.Errorf("Expected 840 got %s", r.amount)
+	}
+}
+
+func TestMoney_DivideErrors(t *testing.T) {
+	if _, err := New(100, EUR).Divide(2, 0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected ErrDivisionByZero got %v", err)
+	}
+
+	if _, err := New(100, EUR).DivideDecimal(decimal.Zero); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected ErrDivisionByZero got %v", err)
+	}
+
+	if _, err := New(100, EUR).Divide(); err == nil {
+		t.Error("Expected error when no divisors are given")
+	}
+}
+
+func TestMoney_ValueArithmetic(t *testing.T) {
+	a, b := *New(12345, EUR), *New(-678, EUR)
+
+	sum, err := a.AddValue(b)
+	if err != nil || sum.Amount() != 11667 {
+		t.Errorf("Expected %d got %d %v", 11667, sum.Amount(), err)
+	}
+
+	diff, err := a.SubtractValue(b)
+	if err != nil || diff.Amount() != 13023 {
+		t.Errorf("Expected %d got %d %v", 13023, diff.Amount(), err)
+	}
+
+	if p := b.MultiplyValue(-3); p.Amount() != 2034 || p.Currency().Code != EUR {
+		t.Errorf("Expected %d %s got %d %s", 2034, EUR, p.Amount(), p.Currency().Code)
+	}
+
+	if _, err := a.AddValue(*New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := a.SubtractValue(*New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoney_ArithmeticOverflow(t *testing.T) {
+	max := New(math.MaxInt64, EUR)
+
+	sum, err := max.Add(New(1, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := decimal.NewFromInt(math.MaxInt64).Add(decimal.NewFromInt(1)); !sum.amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, sum.amount)
+	}
+
+	diff, err := New(math.MinInt64, EUR).Subtract(New(1, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := decimal.NewFromInt(math.MinInt64).Sub(decimal.NewFromInt(1)); !diff.amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, diff.amount)
+	}
+
+	if expected := decimal.NewFromInt(math.MaxInt64).Mul(decimal.NewFromInt(3)); !max.Multiply(3).amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, max.Multiply(3).amount)
+	}
+
+	if expected := decimal.NewFromInt(math.MinInt64).Neg(); !New(-1, EUR).Multiply(math.MinInt64).amount.Equal(expected) {
+		t.Errorf("Expected %s got %s", expected, New(-1, EUR).Multiply(math.MinInt64).amount)
+	}
+}
+
+func BenchmarkMoney_Add(b *testing.B) {
+	x, y := New(12345, EUR), New(678, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Add(y)
+	}
+}
+
+func BenchmarkMoney_AddValue(b *testing.B) {
+	x, y := *New(12345, EUR), *New(678, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.AddValue(y)
+	}
+}
+
+func BenchmarkMoney_AddDecimal(b *testing.B) {
+	x := NewFromDecimal(decimal.RequireFromString("123.455"), EUR)
+	y := New(678, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Add(y)
+	}
+}
+
+func BenchmarkMoney_Multiply(b *testing.B) {
+	x := New(12345, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = x.Multiply(3)
+	}
+}
+
+func BenchmarkMoney_MultiplyValue(b *testing.B) {
+	x := *New(12345, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = x.MultiplyValue(3)
+	}
+}
+
+func BenchmarkMoney_Compare(b *testing.B) {
+	x, y := New(12345, EUR), New(678, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Compare(y)
+	}
+}
+
+func BenchmarkMoney_CompareDecimal(b *testing.B) {
+	x := NewFromDecimal(decimal.RequireFromString("123.455"), EUR)
+	y := New(678, EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = x.Compare(y)
+	}
+}
+
+func TestMoney_FixedPointFastPath(t *testing.T) {
+	d := decimal.RequireFromString
+	a := NewFromDecimal(d("1.005"), EUR)
+	b := DecimalBackend{}
+
+	tcs := []struct {
+		got, expected Amount
+	}{
+		{b.Add(a.amount, New(200, EUR).amount), d("300.5")},
+		{b.Sub(a.amount, New(200, EUR).amount), d("-99.5")},
+		{b.Add(d("9.22337203685477580"), d("1e18")), d("1000000000000000009.22337203685477580")},
+		{b.Sub(d("-999999999999999999"), d("1e19")), d("-10999999999999999999")},
+	}
+
+	for _, tc := range tcs {
+		if !tc.got.Equal(tc.expected) {
+			t.Errorf("Expected %s got %s", tc.expected, tc.got)
+		}
+	}
+
+	if r, err := a.Compare(New(100, EUR)); err != nil || r != 1 {
+		t.Errorf("Expected €1.005 > €1.00 got %d %v", r, err)
+	}
+
+	if r, err := New(101, EUR).Compare(a); err != nil || r != 1 {
+		t.Errorf("Expected €1.01 > €1.005 got %d %v", r, err)
+	}
+}
+
+func TestMoney_SmallestUnit(t *testing.T) {
+	tcs := []struct {
+		code    string
+		display string
+	}{
+		{EUR, "€0.01"},
+		{JPY, "¥1"},
+		{BHD, "0.001 .د.ب"},
+	}
+
+	for _, tc := range tcs {
+		u := New(12345, tc.code).SmallestUnit()
+		if u.Amount() != 1 || u.Currency().Code != tc.code || u.Display() != tc.display {
+			t.Errorf("Expected %s got %s %s", tc.display, u.Display(), u.Currency().Code)
+		}
+	}
+}
+
+func TestMoney_AddSmallestUnits(t *testing.T) {
+	m := New(1000, EUR)
+
+	tcs := []struct {
+		n        int64
+		expected int64
+	}{
+		{1, 1001},
+		{-1, 999},
+		{0, 1000},
+		{-1001, -1},
+	}
+
+	for _, tc := range tcs {
+		r := m.AddSmallestUnits(tc.n)
+		if r.Amount() != tc.expected || r.Currency() != m.Currency() {
+			t.Errorf("Expected %d got %d", tc.expected, r.Amount())
+		}
+	}
+
+	f := NewFromDecimal(decimal.RequireFromString("0.125"), EUR).AddSmallestUnits(1)
+	if !f.Decimal().Equal(decimal.RequireFromString("0.135")) {
+		t.Errorf("Expected %s got %s", "0.135", f.Decimal())
+	}
+
+	if m.Amount() != 1000 {
+		t.Errorf("Expected AddSmallestUnits to leave Self unchanged got %d", m.Amount())
+	}
+}
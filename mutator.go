@@ -5,4 +5,4 @@ type mutator struct {
 }
 
 // initialize our default mutator here.
-var mutate = mutator{calc: &calculator{}}
+var mutate = mutator{calc: &calculator{backend: defaultBackend}}
@@ -0,0 +1,32 @@
+package money
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// lookalikes maps characters that render like the ones used in currency templates, but are encoded
+// differently, to their canonical form. Fullwidth forms are folded by width.Fold.
+var lookalikes = strings.NewReplacer(
+	"\u00a0", " ", // no-break space
+	"\u2007", " ", // figure space
+	"\u2009", " ", // thin space
+	"\u202f", " ", // narrow no-break space
+	"\u2212", "-", // minus sign
+	"\u2019", "'", // right single quotation mark, the Swiss thousand separator
+	"\ufe69", "$", // small dollar sign
+)
+
+// normalize returns s in Unicode NFC, so composed and decomposed spellings of a symbol such as "Kč" are equal.
+func normalize(s string) string {
+	return norm.NFC.String(s)
+}
+
+// fold returns s normalized with its lookalike characters replaced by their canonical form,
+// e.g. a fullwidth yen sign by "¥" and a no-break space by a plain space. Parsers compare folded strings
+// so that invisible encoding differences don't matter.
+func fold(s string) string {
+	return lookalikes.Replace(width.Fold.String(normalize(s)))
+}
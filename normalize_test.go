@@ -0,0 +1,66 @@
+package money
+
+import "testing"
+
+func TestParse_Lookalikes(t *testing.T) {
+	tcs := []struct {
+		input  string
+		amount int64
+		code   string
+	}{
+		{"\uffe51,234", 1234, JPY},                        // fullwidth yen sign
+		{"\uffe11,234.56", 123456, GBP},                   // fullwidth pound sign
+		{"\uff0412.34", 1234, USD},                        // fullwidth dollar sign
+		{"\u221212.34 EUR", -1234, EUR},                   // minus sign
+		{"12.34\u00a0EUR", 1234, EUR},                     // no-break space
+		{"1,234.56 Kc\u030c", 123456, CZK},                // decomposed c with caron
+		{"1,234.56\u202fK\u010d", 123456, CZK},            // narrow no-break space
+		{"\uff11,\uff12\uff13\uff14.56 EUR", 123456, EUR}, // fullwidth digits
+	}
+
+	for _, tc := range tcs {
+		m, err := Parse(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.amount, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestProfile_ParseLookalikes(t *testing.T) {
+	m, err := GetProfile("de-CH").Parse("1\u2019234.56", CHF)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != 123456 {
+		t.Errorf("Expected %d got %d", 123456, m.Amount())
+	}
+}
+
+func TestAddCurrency_NormalizesSymbol(t *testing.T) {
+	r := NewRegistry()
+	c, err := r.AddCurrency("DEC", "Kc\u030c", "1 $", ",", ".", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Grapheme != "K\u010d" {
+		t.Errorf("Expected NFC grapheme %q got %q", "K\u010d", c.Grapheme)
+	}
+
+	if s := r.New(123456, "DEC").Display(); s != "1.234,56 K\u010d" {
+		t.Errorf("Expected %q got %q", "1.234,56 K\u010d", s)
+	}
+}
+
+func TestNewFormatter_NFC(t *testing.T) {
+	f := NewFormatter(2, ",", ".", "Kc\u030c", "1 $")
+	if s := f.Format(100); s != "1,00 K\u010d" {
+		t.Errorf("Expected %q got %q", "1,00 K\u010d", s)
+	}
+}
@@ -0,0 +1,207 @@
+package money
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Confidence tells how much of a parsed amount was read as written, in decreasing order of confidence.
+type Confidence int
+
+const (
+	// ConfidenceExact means the input was parsed as written.
+	ConfidenceExact Confidence = iota
+	// ConfidenceRepaired means OCR artifacts, such as spaces inside the number or an "O" for a "0", were repaired.
+	ConfidenceRepaired
+	// ConfidenceInferred means the decimal separator was missing and inferred from the currency fraction,
+	// e.g. "$1234" read as $12.34, see Parser.InferDecimal.
+	ConfidenceInferred
+)
+
+var confidenceNames = map[Confidence]string{
+	ConfidenceExact:    "exact",
+	ConfidenceRepaired: "repaired",
+	ConfidenceInferred: "inferred",
+}
+
+// String returns the name of the confidence, e.g. "repaired".
+func (c Confidence) String() string {
+	if name, ok := confidenceNames[c]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// ocrDigits maps letters that OCR commonly reads in place of digits to those digits.
+var ocrDigits = map[rune]rune{
+	'O': '0',
+	'o': '0',
+	'I': '1',
+	'l': '1',
+	'|': '1',
+}
+
+// ParseWithConfidence is like Parse, also returning how confidently the amount was read. Without OCR or
+// InferDecimal set, the confidence is always ConfidenceExact.
+func (p *Parser) ParseWithConfidence(s string) (*Money, Confidence, error) {
+	return p.parseOCR(s, p.parse)
+}
+
+// ParseWithCurrencyAndConfidence is like ParseWithCurrency, also returning how confidently the amount was read.
+// Without OCR or InferDecimal set, the confidence is always ConfidenceExact.
+func (p *Parser) ParseWithCurrencyAndConfidence(s, code string) (*Money, Confidence, error) {
+	return p.parseOCR(s, func(s string) (*Money, error) {
+		return p.parseWithCurrency(s, code)
+	})
+}
+
+// parseOCR parses s with parse, repairing OCR artifacts first in OCR mode, and inferring a missing decimal
+// separator after with InferDecimal.
+func (p *Parser) parseOCR(s string, parse func(string) (*Money, error)) (*Money, Confidence, error) {
+	if !p.OCR && !p.InferDecimal {
+		m, err := parse(s)
+		return m, ConfidenceExact, err
+	}
+
+	confidence := ConfidenceExact
+	digits, repaired := s, s
+	if p.OCR {
+		digits = repairDigits(s)
+		repaired = joinDigits(digits)
+		if repaired != fold(s) {
+			confidence = ConfidenceRepaired
+		}
+	}
+
+	m, err := parse(repaired)
+	if err != nil {
+		return nil, confidence, err
+	}
+
+	if fraction := m.currency.Fraction; p.InferDecimal && fraction > 0 && m.amount.IsInteger() &&
+		missingDecimal(repaired, fraction) && !thousandGroups(digits) {
+		m = &Money{amount: m.amount.Shift(-int32(fraction)), currency: m.currency}
+		confidence = ConfidenceInferred
+	}
+
+	return m, confidence, nil
+}
+
+// repairDigits returns s with letters read in place of digits replaced by them. A letter is only replaced
+// in a run of digits and separators holding at least one real digit, and when it isn't next to another letter,
+// so that currency symbols such as "lei" or "BOB" are kept.
+func repairDigits(s string) string {
+	rs := []rune(fold(s))
+
+	numeric := func(r rune) bool {
+		_, ok := ocrDigits[r]
+		return isDigit(r) || ok || r == '.' || r == ','
+	}
+
+	for start := 0; start < len(rs); {
+		if !numeric(rs[start]) {
+			start++
+			continue
+		}
+
+		end, digits := start, false
+		for ; end < len(rs) && numeric(rs[end]); end++ {
+			digits = digits || isDigit(rs[end])
+		}
+
+		for i := start; digits && i < end; i++ {
+			d, ok := ocrDigits[rs[i]]
+			if ok && !wordLetter(rs, i-1) && !wordLetter(rs, i+1) {
+				rs[i] = d
+			}
+		}
+
+		start = end
+	}
+
+	return string(rs)
+}
+
+// joinDigits returns s with spaces between digits removed.
+func joinDigits(s string) string {
+	rs := []rune(s)
+	out := make([]rune, 0, len(rs))
+	for i, r := range rs {
+		if unicode.IsSpace(r) && len(out) > 0 && isDigit(out[len(out)-1]) {
+			j := i
+			for j < len(rs) && unicode.IsSpace(rs[j]) {
+				j++
+			}
+			if j < len(rs) && isDigit(rs[j]) {
+				continue
+			}
+		}
+
+		out = append(out, r)
+	}
+
+	return string(out)
+}
+
+// missingDecimal reports whether s holds a single run of more digits than the fraction, without any separator,
+// as left when OCR drops the decimal separator of a receipt amount.
+func missingDecimal(s string, fraction int) bool {
+	first, last := -1, -1
+	for i, r := range s {
+		if isDigit(r) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+
+	if first < 0 || last-first+1 <= fraction {
+		return false
+	}
+
+	for _, r := range s[first : last+1] {
+		if !isDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// thousandGroups reports whether the digits of s are written in groups separated by spaces, all but the first
+// of three digits, such as "1 000": thousand separators rather than a dropped decimal separator.
+func thousandGroups(s string) bool {
+	first, last := strings.IndexFunc(s, isDigit), strings.LastIndexFunc(s, isDigit)
+	if first < 0 {
+		return false
+	}
+
+	groups := strings.Fields(s[first : last+1])
+	if len(groups) < 2 {
+		return false
+	}
+
+	for _, g := range groups[1:] {
+		if len(g) != 3 || strings.IndexFunc(g, func(r rune) bool { return !isDigit(r) }) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// wordLetter reports whether rs[i] exists and is a letter that OCR doesn't read in place of a digit.
+func wordLetter(rs []rune, i int) bool {
+	if i < 0 || i >= len(rs) {
+		return false
+	}
+
+	_, ok := ocrDigits[rs[i]]
+	return unicode.IsLetter(rs[i]) && !ok
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,114 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParser_OCR(t *testing.T) {
+	p := &Parser{OCR: true, DefaultCurrency: EUR}
+
+	tcs := []struct {
+		input      string
+		code       string
+		amount     int64
+		confidence Confidence
+	}{
+		{"€12.34", EUR, 1234, ConfidenceExact},
+		{"€1 2.34", EUR, 1234, ConfidenceRepaired},
+		{"€1O.5O", EUR, 1050, ConfidenceRepaired},
+		{"€l2.3o", EUR, 1230, ConfidenceRepaired},
+		{"1 234.56 zł", PLN, 123456, ConfidenceRepaired},
+		{"lei1.0O", RON, 100, ConfidenceRepaired},
+		{"€1234", EUR, 123400, ConfidenceExact},
+		{"$100", USD, 10000, ConfidenceExact},
+		{"-$100", USD, -10000, ConfidenceExact},
+		{"$99", USD, 9900, ConfidenceExact},
+		{"€1 000", EUR, 100000, ConfidenceRepaired},
+		{"€5", EUR, 500, ConfidenceExact},
+		{"¥1234", JPY, 1234, ConfidenceExact},
+		{"-€1O.00", EUR, -1000, ConfidenceRepaired},
+		{"12.34", EUR, 1234, ConfidenceExact},
+	}
+
+	for _, tc := range tcs {
+		m, confidence, err := p.ParseWithConfidence(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code || confidence != tc.confidence {
+			t.Errorf("Expected %q to parse into %d %s (%s) got %d %s (%s)", tc.input, tc.amount, tc.code, tc.confidence,
+				m.Amount(), m.Currency().Code, confidence)
+		}
+	}
+}
+
+func TestParser_InferDecimal(t *testing.T) {
+	tcs := []struct {
+		parser     *Parser
+		input      string
+		amount     int64
+		confidence Confidence
+	}{
+		{&Parser{OCR: true, InferDecimal: true}, "€1234", 1234, ConfidenceInferred},
+		{&Parser{OCR: true, InferDecimal: true}, "€12 34", 1234, ConfidenceInferred},
+		{&Parser{OCR: true, InferDecimal: true}, "€1 000", 100000, ConfidenceRepaired},
+		{&Parser{OCR: true, InferDecimal: true}, "€12 345 678", 1234567800, ConfidenceRepaired},
+		{&Parser{OCR: true, InferDecimal: true}, "€12.34", 1234, ConfidenceExact},
+		{&Parser{InferDecimal: true}, "$100", 100, ConfidenceInferred},
+		{&Parser{InferDecimal: true}, "-$100", -100, ConfidenceInferred},
+		{&Parser{InferDecimal: true}, "$99", 9900, ConfidenceExact},
+		{&Parser{InferDecimal: true}, "¥1234", 1234, ConfidenceExact},
+	}
+
+	for _, tc := range tcs {
+		m, confidence, err := tc.parser.ParseWithConfidence(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || confidence != tc.confidence {
+			t.Errorf("Expected %q to parse into %d (%s) got %d (%s)", tc.input, tc.amount, tc.confidence, m.Amount(), confidence)
+		}
+	}
+}
+
+func TestParser_OCRDisabled(t *testing.T) {
+	p := &Parser{}
+
+	m, confidence, err := p.ParseWithConfidence("€1234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Amount() != 123400 || confidence != ConfidenceExact {
+		t.Errorf("Expected 123400 (exact) got %d (%s)", m.Amount(), confidence)
+	}
+
+	if _, err := p.Parse("€1O.5O"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency got %v", err)
+	}
+}
+
+func TestParser_OCRWithCurrency(t *testing.T) {
+	m, confidence, err := (&Parser{OCR: true, InferDecimal: true}).ParseWithCurrencyAndConfidence("$1 9 99", CAD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != 1999 || m.Currency().Code != CAD || confidence != ConfidenceInferred {
+		t.Errorf("Expected 1999 CAD (inferred) got %d %s (%s)", m.Amount(), m.Currency().Code, confidence)
+	}
+}
+
+func TestConfidence_String(t *testing.T) {
+	if s := ConfidenceRepaired.String(); s != "repaired" {
+		t.Errorf("Expected %s got %s", "repaired", s)
+	}
+
+	if s := Confidence(-1).String(); s != "unknown" {
+		t.Errorf("Expected %s got %s", "unknown", s)
+	}
+}
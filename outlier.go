@@ -0,0 +1,101 @@
+package money
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidThreshold happens when an outlier threshold is negative.
+var ErrInvalidThreshold = newError("money.invalid_threshold", "outlier threshold must not be negative")
+
+// Outlier is a Money flagged by OutliersIQR or OutliersZScore, with its index in the checked slice.
+type Outlier struct {
+	Index int
+	Money *Money
+}
+
+// OutliersIQR flags the Money outside [Q1 - k*IQR, Q3 + k*IQR], where Q1 and Q3 are the first and third
+// quartiles and IQR = Q3 - Q1. Tukey's fences use k = 1.5. Quartiles are interpolated exactly, without floats.
+// Outliers are returned in their order in values.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned.
+func OutliersIQR(values []*Money, k decimal.Decimal) ([]Outlier, error) {
+	if err := checkOutliers(values, k); err != nil || len(values) == 0 {
+		return []Outlier{}, err
+	}
+
+	sorted := make([]decimal.Decimal, len(values))
+	for i, m := range values {
+		sorted[i] = m.amount
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LessThan(sorted[j]) })
+
+	q1, q3 := quartile(sorted, 1), quartile(sorted, 3)
+	fence := q3.Sub(q1).Mul(k)
+	low, high := q1.Sub(fence), q3.Add(fence)
+
+	return flagOutliers(values, func(a decimal.Decimal) bool {
+		return a.LessThan(low) || a.GreaterThan(high)
+	}), nil
+}
+
+// OutliersZScore flags the Money more than z population standard deviations away from the mean; z = 3 is common.
+// The check is done exactly, comparing squares rather than taking a square root.
+// Outliers are returned in their order in values.
+// All Money must share a currency, otherwise ErrCurrencyMismatch is returned.
+func OutliersZScore(values []*Money, z decimal.Decimal) ([]Outlier, error) {
+	if err := checkOutliers(values, z); err != nil || len(values) == 0 {
+		return []Outlier{}, err
+	}
+
+	// |x - mean| > z * sd  <=>  (n*x - sum)^2 > z^2 * (n*sumSquares - sum^2)
+	n := decimal.NewFromInt(int64(len(values)))
+	sum, squares := decimal.Zero, decimal.Zero
+	for _, m := range values {
+		sum = mutate.calc.add(sum, m.amount)
+		squares = mutate.calc.add(squares, mutate.calc.multiplyDecimal(m.amount, m.amount))
+	}
+	limit := z.Mul(z).Mul(n.Mul(squares).Sub(sum.Mul(sum)))
+
+	return flagOutliers(values, func(a decimal.Decimal) bool {
+		d := n.Mul(a).Sub(sum)
+		return d.Mul(d).GreaterThan(limit)
+	}), nil
+}
+
+func checkOutliers(values []*Money, threshold decimal.Decimal) error {
+	if threshold.IsNegative() {
+		return ErrInvalidThreshold
+	}
+
+	for _, m := range values {
+		if err := values[0].assertSameCurrency(m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// quartile returns the q-th quartile of sorted amounts, interpolating linearly between neighbours.
+func quartile(sorted []decimal.Decimal, q int) decimal.Decimal {
+	pos := q * (len(sorted) - 1)
+	i, rem := pos/4, pos%4
+	if rem == 0 {
+		return sorted[i]
+	}
+
+	frac := decimal.New(int64(rem*25), -2)
+	return sorted[i].Add(sorted[i+1].Sub(sorted[i]).Mul(frac))
+}
+
+func flagOutliers(values []*Money, outside func(decimal.Decimal) bool) []Outlier {
+	out := []Outlier{}
+	for i, m := range values {
+		if outside(m.amount) {
+			out = append(out, Outlier{Index: i, Money: m})
+		}
+	}
+
+	return out
+}
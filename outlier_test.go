@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func moneys(code string, amounts ...int64) []*Money {
+	ms := make([]*Money, len(amounts))
+	for i, a := range amounts {
+		ms[i] = New(a, code)
+	}
+
+	return ms
+}
+
+func TestOutliersIQR(t *testing.T) {
+	ms := moneys(EUR, 10, 12, 12, 13, 12, 11, 14, 13, 15, 10, 10, 10, 100, 12)
+
+	out, err := OutliersIQR(ms, decimal.RequireFromString("1.5"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != 1 || out[0].Index != 12 || out[0].Money != ms[12] {
+		t.Errorf("Expected index 12 to be flagged got %v", out)
+	}
+
+	// Q1 = 10.25 and Q3 = 13, so k = 0 flags everything outside [10.25, 13]
+	out, err = OutliersIQR(ms, decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []int{0, 6, 8, 9, 10, 11, 12}
+	if len(out) != len(expected) {
+		t.Fatalf("Expected %v to be flagged got %v", expected, out)
+	}
+
+	for i, o := range out {
+		if o.Index != expected[i] {
+			t.Errorf("Expected %v to be flagged got %v", expected, out)
+		}
+	}
+}
+
+func TestOutliersZScore(t *testing.T) {
+	ms := moneys(EUR, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 1000)
+
+	out, err := OutliersZScore(ms, decimal.NewFromInt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != 1 || out[0].Index != 10 {
+		t.Errorf("Expected index 10 to be flagged got %v", out)
+	}
+
+	// mean 1 and standard deviation 1: values exactly one deviation away aren't flagged
+	if out, _ := OutliersZScore(moneys(EUR, 0, 2), decimal.NewFromInt(1)); len(out) != 0 {
+		t.Errorf("Expected no outliers got %v", out)
+	}
+
+	if out, _ := OutliersZScore(moneys(EUR, 5, 5, 5), decimal.Zero); len(out) != 0 {
+		t.Errorf("Expected no outliers got %v", out)
+	}
+}
+
+func TestOutliers_Errors(t *testing.T) {
+	if out, err := OutliersIQR(nil, decimal.NewFromInt(1)); err != nil || len(out) != 0 {
+		t.Errorf("Expected no outliers got %v %v", out, err)
+	}
+
+	if _, err := OutliersZScore(moneys(EUR, 1), decimal.NewFromInt(-1)); err != ErrInvalidThreshold {
+		t.Errorf("Expected %v got %v", ErrInvalidThreshold, err)
+	}
+
+	ms := []*Money{New(1, EUR), New(1, USD)}
+	if _, err := OutliersIQR(ms, decimal.NewFromInt(1)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+
+	if _, err := OutliersZScore(ms, decimal.NewFromInt(1)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,399 @@
+package money
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInvalidAmount happens when a string can't be parsed into a monetary amount.
+	ErrInvalidAmount = newError("money.invalid_amount", "invalid amount")
+
+	// ErrUnknownCurrency happens when no registered currency matches a parsed string.
+	ErrUnknownCurrency = newError("money.unknown_currency", "unknown currency")
+
+	// ErrAmbiguousCurrency happens when several registered currencies match a parsed string equally well.
+	ErrAmbiguousCurrency = newError("money.ambiguous_currency", "ambiguous currency")
+
+	// ErrCurrencyNotAllowed happens when a Parser recognises a currency outside its allowed currencies.
+	ErrCurrencyNotAllowed = newError("money.currency_not_allowed", "currency not allowed")
+)
+
+// preferredCurrencies resolves graphemes shared by several currencies to the one Parse picks.
+var preferredCurrencies = map[string]string{
+	"$":              USD,
+	"\u00a3":         GBP,
+	".\u062f.\u0625": AED,
+	"\u20a9":         KRW,
+	"\u20bd":         RUB,
+	"p.":             BYN,
+	"Db":             STN,
+	"Le":             SLE,
+}
+
+// Parser parses formatted money strings. Its zero value accepts the same input as Parse and ParseWithCurrency;
+// set its fields to accept more.
+type Parser struct {
+	// Registry holds the currencies recognised by the parser; the DefaultRegistry is used when nil.
+	Registry *Registry
+
+	// AllowExponent accepts amounts written in exponent notation, such as "1.2e3" or "5E-2",
+	// which some scientific tools emit. Without it such input is rejected with ErrInvalidAmount.
+	AllowExponent bool
+
+	// Locale is the BCP 47 locale of the parsed documents. Symbols shared by several currencies resolve to the
+	// currency of its region when it uses the symbol, e.g. "$" to CAD for "en-CA", before their most common one.
+	Locale string
+
+	// DefaultCurrency is the currency code assumed for amounts written without symbol or code, such as "12.34".
+	// It also wins over the Locale when a shared symbol could mean it. Without it bare amounts are rejected
+	// with ErrUnknownCurrency.
+	DefaultCurrency string
+
+	// AllowedCurrencies restricts the currency codes the parser recognises. A symbol shared by several currencies
+	// resolves to an allowed one; input only matching other currencies is rejected with a *CurrencyNotAllowedError.
+	// All registered currencies are allowed when it is empty.
+	AllowedCurrencies []string
+
+	// OCR tolerates artifacts of text recognition in scanned receipts: spaces inside numbers and letters such as
+	// "O" and "l" read in place of digits. Use ParseWithConfidence to learn which repairs were needed.
+	OCR bool
+
+	// InferDecimal reads a run of more digits than the currency fraction without any separator as minor units,
+	// for sources known to drop the decimal separator: "$1234" is read as $12.34, and so is "$100" as $1.00,
+	// while "$99" stays $99. Digits grouped by three with spaces, such as "€1 000", are read as written.
+	InferDecimal bool
+}
+
+// CurrencyNotAllowedError happens when a Parser recognises a currency outside its allowed currencies, such as
+// "$12.34" on an invoice expected in EUR or GBP. It wraps ErrCurrencyNotAllowed.
+type CurrencyNotAllowedError struct {
+	Input   string
+	Codes   []string
+	Allowed []string
+}
+
+// Error implements error.
+func (e *CurrencyNotAllowedError) Error() string {
+	return fmt.Sprintf("%v: %q is %s, allowed %s", ErrCurrencyNotAllowed, e.Input, strings.Join(e.Codes, " or "), strings.Join(e.Allowed, ", "))
+}
+
+// Unwrap returns ErrCurrencyNotAllowed, so that errors.Is(err, ErrCurrencyNotAllowed) holds for whitelist errors.
+func (e *CurrencyNotAllowedError) Unwrap() error {
+	return ErrCurrencyNotAllowed
+}
+
+// Parse parses a string formatted by Display, such as "£1,234.56", "1.00 .د.إ" or "-$0.01", back into Money.
+// The currency is recognised from its registered grapheme and template, or from its ISO code written before
+// or after the amount ("EUR 12.34", "12.34 EUR"). When several currencies match, the one with the longest
+// symbol wins and shared symbols resolve to their most common currency ("$" is USD, "£" is GBP);
+// otherwise an *AmbiguousCurrencyError is returned; use a Parser with a Locale to resolve them by region.
+// Use ParseWithCurrency when the currency is known up front.
+// Amounts in exponent notation are rejected; use a Parser to accept them.
+func Parse(s string) (*Money, error) {
+	return (&Parser{}).Parse(s)
+}
+
+// ParseWithCurrency parses a string formatted by Display into Money of the given currency.
+// Besides the currency grapheme, the amount may be written with the ISO code before or after it,
+// or without any symbol at all. Amounts in exponent notation are rejected; use a Parser to accept them.
+func ParseWithCurrency(s, code string) (*Money, error) {
+	return (&Parser{}).ParseWithCurrency(s, code)
+}
+
+// Parse is like the package-level Parse, using the parser settings.
+func (p *Parser) Parse(s string) (*Money, error) {
+	m, _, err := p.ParseWithConfidence(s)
+	return m, err
+}
+
+func (p *Parser) parse(s string) (*Money, error) {
+	var found []parsed
+	for _, c := range p.registry().sorted() {
+		if r, ok := p.parseIn(s, c, false); ok {
+			found = append(found, r)
+		}
+	}
+
+	if len(found) == 0 && p.DefaultCurrency != "" {
+		if r, ok := p.parseIn(s, p.registry().get(p.DefaultCurrency), true); ok {
+			found = append(found, r)
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCurrency, s)
+	}
+
+	if len(p.AllowedCurrencies) > 0 {
+		allowed := found[:0:0]
+		for _, r := range found {
+			if p.allows(r.money.currency.Code) {
+				allowed = append(allowed, r)
+			}
+		}
+
+		if len(allowed) == 0 {
+			codes := make([]string, len(found))
+			for i, r := range found {
+				codes[i] = r.money.currency.Code
+			}
+			return nil, p.notAllowed(s, codes...)
+		}
+
+		found = allowed
+	}
+
+	// Keep the matches with the most specific symbol only.
+	best := found[:0]
+	for _, r := range found {
+		switch {
+		case len(best) == 0 || len(r.symbol) == len(best[0].symbol):
+			best = append(best, r)
+		case len(r.symbol) > len(best[0].symbol):
+			best = append(best[:0], r)
+		}
+	}
+
+	if len(best) == 1 {
+		return best[0].money, nil
+	}
+
+	cs := make([]*Currency, len(best))
+	for i, r := range best {
+		cs[i] = r.money.currency
+		if r.money.currency.Code == strings.ToUpper(p.DefaultCurrency) {
+			return r.money, nil
+		}
+	}
+
+	if c := pickCurrency(fold(best[0].symbol), p.Locale, cs); c != nil {
+		for _, r := range best {
+			if r.money.currency == c {
+				return r.money, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("parsing %q: %w", s, &AmbiguousCurrencyError{Symbol: best[0].symbol, Candidates: cs})
+}
+
+// ParseWithCurrency is like the package-level ParseWithCurrency, using the parser settings.
+func (p *Parser) ParseWithCurrency(s, code string) (*Money, error) {
+	m, _, err := p.ParseWithCurrencyAndConfidence(s, code)
+	return m, err
+}
+
+func (p *Parser) parseWithCurrency(s, code string) (*Money, error) {
+	c := p.registry().get(code)
+	if !p.allows(c.Code) {
+		return nil, p.notAllowed(s, c.Code)
+	}
+
+	r, ok := p.parseIn(s, c, true)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a valid %s amount", ErrInvalidAmount, s, c.Code)
+	}
+
+	return r.money, nil
+}
+
+// allows reports whether the currency code is among the allowed currencies of the parser.
+func (p *Parser) allows(code string) bool {
+	if len(p.AllowedCurrencies) == 0 {
+		return true
+	}
+
+	for _, a := range p.AllowedCurrencies {
+		if strings.ToUpper(a) == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notAllowed returns the error for input recognised in currencies outside the allowed currencies.
+func (p *Parser) notAllowed(s string, codes ...string) error {
+	allowed := make([]string, len(p.AllowedCurrencies))
+	for i, a := range p.AllowedCurrencies {
+		allowed[i] = strings.ToUpper(a)
+	}
+
+	return &CurrencyNotAllowedError{Input: s, Codes: codes, Allowed: allowed}
+}
+
+func (p *Parser) registry() *Registry {
+	if p.Registry == nil {
+		return DefaultRegistry
+	}
+
+	return p.Registry
+}
+
+// parsed is the result of matching a string against a single currency.
+type parsed struct {
+	money  *Money
+	symbol string
+}
+
+// parseIn matches s against the formats of currency c: its display template, its code written before or
+// after the amount and, when bare is set, the amount alone.
+func (p *Parser) parseIn(s string, c *Currency, bare bool) (parsed, bool) {
+	trimmed := strings.TrimSpace(fold(s))
+	neg := strings.HasPrefix(trimmed, "-")
+	if neg {
+		trimmed = strings.TrimSpace(trimmed[1:])
+	}
+
+	type affix struct{ prefix, suffix, symbol string }
+	var forms []affix
+
+	if c.Grapheme != "" {
+		prefix, suffix := c.Template, ""
+		if i := strings.Index(c.Template, "1"); i >= 0 {
+			prefix, suffix = c.Template[:i], c.Template[i+1:]
+		}
+		forms = append(forms, affix{
+			prefix: fold(strings.Replace(prefix, "$", c.Grapheme, 1)),
+			suffix: fold(strings.Replace(suffix, "$", c.Grapheme, 1)),
+			symbol: c.Grapheme,
+		})
+	}
+
+	forms = append(forms, affix{prefix: c.Code, symbol: c.Code}, affix{suffix: c.Code, symbol: c.Code})
+
+	if bare {
+		forms = append(forms, affix{})
+	}
+
+	for _, form := range forms {
+		num, ok := cutAffixes(trimmed, strings.TrimSpace(form.prefix), strings.TrimSpace(form.suffix))
+		if !ok || (neg && strings.ContainsAny(num[:1], "+-")) {
+			continue
+		}
+
+		f := numberFormat{decimal: c.Decimal, thousand: c.Thousand, exponent: p.AllowExponent}
+		amount, err := parseAmount(num, f, c.Code, c.Fraction)
+		if err != nil {
+			continue
+		}
+
+		if neg {
+			amount = amount.Neg()
+		}
+
+		return parsed{money: &Money{amount: amount, currency: c}, symbol: form.symbol}, true
+	}
+
+	return parsed{}, false
+}
+
+// cutAffixes returns s without the given prefix and suffix, and whether both were present.
+func cutAffixes(s, prefix, suffix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return "", false
+	}
+
+	s = s[len(prefix):]
+	if !strings.HasSuffix(s, suffix) {
+		return "", false
+	}
+
+	s = strings.TrimSpace(s[:len(s)-len(suffix)])
+	return s, s != ""
+}
+
+// maxExponent bounds the exponents accepted in exponent notation, far beyond any monetary magnitude.
+const maxExponent = 64
+
+// numberFormat describes how the numeric part of an amount is written.
+type numberFormat struct {
+	decimal  string
+	thousand string
+	exponent bool
+}
+
+// parseAmount parses a number written in the given format into an amount of minor units of a currency
+// with the given fraction. Lookalike characters in the number and the separators are folded first.
+func parseAmount(s string, f numberFormat, code string, fraction int) (Amount, error) {
+	d, err := parseNumber(s, f)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	amount := d.Shift(int32(fraction))
+	if !amount.IsInteger() {
+		return Amount{}, &PrecisionError{Code: code, Fraction: fraction, Amount: strings.TrimSpace(s)}
+	}
+
+	return amount, nil
+}
+
+// parseNumber parses a number written in the given format into a decimal.
+// Lookalike characters in the number and the separators are folded first.
+func parseNumber(s string, f numberFormat) (decimal.Decimal, error) {
+	num := strings.TrimSpace(fold(s))
+	f.decimal, f.thousand = fold(f.decimal), fold(f.thousand)
+
+	neg := false
+	switch {
+	case strings.HasPrefix(num, "-"):
+		neg = true
+		num = num[1:]
+	case strings.HasPrefix(num, "+"):
+		num = num[1:]
+	}
+
+	if f.thousand != "" {
+		num = strings.ReplaceAll(num, f.thousand, "")
+	}
+
+	exp := 0
+	if i := strings.IndexAny(num, "eE"); i >= 0 {
+		if !f.exponent {
+			return decimal.Decimal{}, fmt.Errorf("%w: %q uses exponent notation", ErrInvalidAmount, s)
+		}
+
+		e, err := strconv.Atoi(num[i+1:])
+		if err != nil || e > maxExponent || e < -maxExponent {
+			return decimal.Decimal{}, fmt.Errorf("%w: %q has an invalid exponent", ErrInvalidAmount, s)
+		}
+		num, exp = num[:i], e
+	}
+
+	whole, frac := num, ""
+	if i := strings.Index(num, f.decimal); f.decimal != "" && i >= 0 {
+		whole, frac = num[:i], num[i+len(f.decimal):]
+	}
+
+	if whole+frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	d, err := decimal.NewFromString(whole + frac)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	d = d.Shift(int32(exp - len(frac)))
+	if neg {
+		d = d.Neg()
+	}
+
+	return d, nil
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,222 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		input  string
+		amount int64
+		code   string
+	}{
+		{"£1,234.56", 123456, GBP},
+		{"1.00 .د.إ", 100, AED},
+		{"-$0.01", -1, USD},
+		{"$-0.01", -1, USD},
+		{"$1,234,567.89", 123456789, USD},
+		{"R$1.234,56", 123456, BRL},
+		{"A$10", 1000, AUD},
+		{"€0.5", 50, EUR},
+		{"12.34 EUR", 1234, EUR},
+		{"EUR 12.34", 1234, EUR},
+		{"-12.34 eur", 0, ""},
+		{"¥1,234", 1234, JPY},
+		{"  -  1,000.000 .د.ب  ", -1000000, BHD},
+	}
+
+	for _, tc := range tcs {
+		m, err := Parse(tc.input)
+		if tc.code == "" {
+			if err == nil {
+				t.Errorf("Expected error parsing %q got %s %s", tc.input, m.Display(), m.Currency().Code)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.amount, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{"", ErrUnknownCurrency},
+		{"hello", ErrUnknownCurrency},
+		{"1,234.56", ErrUnknownCurrency},
+		{"$1.234", ErrAmbiguousCurrency},
+		{"$1.234,56", ErrAmbiguousCurrency},
+		{"--$1", ErrUnknownCurrency},
+		{"-$-1", ErrUnknownCurrency},
+		{"kr1.00", ErrAmbiguousCurrency},
+		{"1 ﷼", ErrAmbiguousCurrency},
+	}
+
+	for _, tc := range tcs {
+		_, err := Parse(tc.input)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v parsing %q got %v", tc.err, tc.input, err)
+		}
+	}
+}
+
+func TestParseWithCurrency(t *testing.T) {
+	tcs := []struct {
+		input  string
+		code   string
+		amount int64
+	}{
+		{"1,234.56", USD, 123456},
+		{"$1.00", CAD, 100},
+		{"1.00 .د.إ", JOD, 1000},
+		{"CAD 1.5", CAD, 150},
+		{"1.5 CAD", CAD, 150},
+		{"-1 234,56 p.", BYN, -123456},
+		{"1.00FOO", "FOO", 100},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseWithCurrency(tc.input, tc.code)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.amount, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+
+	for _, input := range []string{"€1", "$1.001", "USD", "1.00 EUR"} {
+		if _, err := ParseWithCurrency(input, USD); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected ErrInvalidAmount parsing %q got %v", input, err)
+		}
+	}
+}
+
+func TestParser_AllowExponent(t *testing.T) {
+	tcs := []struct {
+		input  string
+		amount int64
+		code   string
+	}{
+		{"$1.2e3", 120000, USD},
+		{"5E-2 USD", 5, USD},
+		{"-1.5e+1 EUR", -1500, EUR},
+		{"1e0 EUR", 100, EUR},
+		{"0.00123e3 EUR", 123, EUR},
+	}
+
+	p := &Parser{AllowExponent: true}
+	for _, tc := range tcs {
+		m, err := p.Parse(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.amount, tc.code, m.Amount(), m.Currency().Code)
+		}
+
+		if _, err := Parse(tc.input); err == nil {
+			t.Errorf("Expected exponent notation in %q to be rejected by default", tc.input)
+		}
+	}
+
+	for _, input := range []string{"1e-3", "1e", "1e1.5", "e3", "1e999", "1e3e3"} {
+		if _, err := p.ParseWithCurrency(input, USD); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected ErrInvalidAmount parsing %q got %v", input, err)
+		}
+	}
+}
+
+func TestParseAmount_ExponentErrors(t *testing.T) {
+	f := numberFormat{decimal: ".", thousand: ","}
+
+	_, err := parseAmount("1.2e3", f, USD, 2)
+	if err == nil || err.Error() != `invalid amount: "1.2e3" uses exponent notation` {
+		t.Errorf("Expected explicit exponent rejection got %v", err)
+	}
+
+	f.exponent = true
+	_, err = parseAmount("1.2345e1", f, USD, 2)
+	if !errors.As(err, new(*PrecisionError)) {
+		t.Errorf("Expected *PrecisionError got %v", err)
+	}
+}
+
+func TestParser_DefaultCurrency(t *testing.T) {
+	p := &Parser{DefaultCurrency: "cad"}
+
+	tcs := []struct {
+		input  string
+		code   string
+		amount int64
+	}{
+		{"12.34", CAD, 1234},
+		{"-1,234.5", CAD, -123450},
+		{"$1.00", CAD, 100},
+		{"€1.00", EUR, 100},
+		{"1.00 USD", USD, 100},
+	}
+
+	for _, tc := range tcs {
+		m, err := p.Parse(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.amount, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+
+	if _, err := (&Parser{}).Parse("12.34"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency got %v", err)
+	}
+}
+
+func TestParser_AllowedCurrencies(t *testing.T) {
+	p := &Parser{AllowedCurrencies: []string{"eur", MXN}, DefaultCurrency: EUR}
+
+	for input, code := range map[string]string{"$1.00": MXN, "€1.00": EUR, "1.00": EUR} {
+		m, err := p.Parse(input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", input, err)
+			continue
+		}
+
+		if m.Currency().Code != code {
+			t.Errorf("Expected %q to parse into %s got %s", input, code, m.Currency().Code)
+		}
+	}
+
+	_, err := p.Parse("£1.00")
+	var notAllowed *CurrencyNotAllowedError
+	if !errors.As(err, &notAllowed) || !errors.Is(err, ErrCurrencyNotAllowed) {
+		t.Fatalf("Expected *CurrencyNotAllowedError got %v", err)
+	}
+	if len(notAllowed.Codes) == 0 || notAllowed.Allowed[0] != EUR || notAllowed.Allowed[1] != MXN {
+		t.Errorf("Expected GBP not allowed in [EUR MXN] got %v in %v", notAllowed.Codes, notAllowed.Allowed)
+	}
+
+	if _, err := p.ParseWithCurrency("1.00", USD); !errors.Is(err, ErrCurrencyNotAllowed) {
+		t.Errorf("Expected ErrCurrencyNotAllowed got %v", err)
+	}
+
+	if _, err := p.ParseWithCurrency("1.00", "eur"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
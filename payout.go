@@ -0,0 +1,283 @@
+package money
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	// ErrFieldOverflow happens when a value is wider than the field of a fixed-width payout layout.
+	ErrFieldOverflow = newError("money.field_overflow", "value doesn't fit its field")
+
+	// ErrInvalidField happens when a payout field holds a line break or another control character, which could
+	// forge records of the file, or when a fixed-width field is padded with a non-ASCII byte.
+	ErrInvalidField = newError("money.invalid_field", "invalid payout field")
+)
+
+// Payout is a single payout instruction: Money to pay to a recipient.
+type Payout struct {
+	Recipient string
+	Money     *Money
+}
+
+// PayoutField selects the value written in a field of a payout file.
+type PayoutField int
+
+const (
+	// FieldLiteral writes a fixed value, such as a record type code.
+	FieldLiteral PayoutField = iota
+	// FieldRecipient writes the recipient of the payout.
+	FieldRecipient
+	// FieldAmount writes the amount of the payout, formatted by the AmountFormat of the layout.
+	FieldAmount
+	// FieldCurrency writes the ISO 4217 code of the payout currency.
+	FieldCurrency
+)
+
+// AmountFormat is the way a payout file spec writes and restricts amounts. Payouts breaking its rules are rejected
+// before anything is written for them, so malformed amounts never reach the bank file.
+type AmountFormat struct {
+	// ImpliedDecimal writes amounts in minor units without a decimal separator, e.g. "1234" for 12.34.
+	ImpliedDecimal bool
+	// Decimal separates major and minor units unless ImpliedDecimal, "." when empty.
+	Decimal string
+	// Currency restricts payouts to the currency with the given code; any currency is accepted when empty.
+	Currency string
+	// AllowNegative accepts negative amounts, written with a leading "-". Zero is always accepted.
+	AllowNegative bool
+}
+
+// Format returns the amount of Money as written by the spec, e.g. "1234" or "12.34".
+func (f AmountFormat) Format(m *Money) string {
+	if f.ImpliedDecimal {
+		return strconv.FormatInt(m.amount.IntPart(), 10)
+	}
+
+	d := f.Decimal
+	if d == "" {
+		d = "."
+	}
+
+	return NewFormatter(m.currency.Fraction, d, "", "", "1").Format(m.amount.IntPart())
+}
+
+// Validate returns an error when Money can't be written by the spec: ErrCurrencyMismatch for another currency,
+// a *PrecisionError for fractions of minor units and ErrInvalidAmount for negative amounts.
+func (f AmountFormat) Validate(m *Money) error {
+	if m == nil || m.currency == nil {
+		return fmt.Errorf("%w: missing payout amount", ErrInvalidAmount)
+	}
+
+	if f.Currency != "" && !strings.EqualFold(m.currency.Code, f.Currency) {
+		return fmt.Errorf("%w: %s payout, want %s", ErrCurrencyMismatch, m.currency.Code, strings.ToUpper(f.Currency))
+	}
+
+	if err := m.CheckPrecision(); err != nil {
+		return err
+	}
+
+	if m.IsNegative() && !f.AllowNegative {
+		return fmt.Errorf("%w: negative payout %s", ErrInvalidAmount, m.Display())
+	}
+
+	return nil
+}
+
+// CSVLayout is the layout of a CSV payout file: the fields of each record, an optional header record,
+// the field separator, ',' when zero, and the amount format. FieldLiteral fields are written empty.
+type CSVLayout struct {
+	Fields []PayoutField
+	Header []string
+	Comma  rune
+	Amount AmountFormat
+}
+
+// CSVPayoutWriter writes payouts as CSV records in a CSVLayout.
+type CSVPayoutWriter struct {
+	w      *csv.Writer
+	layout CSVLayout
+	header bool
+	n      int
+}
+
+// NewCSVPayoutWriter returns a writer of payouts in the CSV layout to w. The header, if any, is written
+// with the first payout or on Flush.
+func NewCSVPayoutWriter(w io.Writer, layout CSVLayout) *CSVPayoutWriter {
+	cw := csv.NewWriter(w)
+	if layout.Comma != 0 {
+		cw.Comma = layout.Comma
+	}
+
+	return &CSVPayoutWriter{w: cw, layout: layout}
+}
+
+// Write validates the payout against the amount format and writes it as one record. Nothing is written
+// for an invalid payout, including fields holding control characters, which fail with ErrInvalidField;
+// the error tells which payout of the file it is, counting from one.
+func (pw *CSVPayoutWriter) Write(p Payout) error {
+	pw.n++
+	if err := pw.layout.Amount.Validate(p.Money); err != nil {
+		return payoutError(pw.n, p, err)
+	}
+
+	rec := make([]string, len(pw.layout.Fields))
+	for i, f := range pw.layout.Fields {
+		rec[i] = payoutValue(f, p, pw.layout.Amount)
+		if err := checkField(rec[i]); err != nil {
+			return payoutError(pw.n, p, err)
+		}
+	}
+
+	if err := pw.writeHeader(); err != nil {
+		return err
+	}
+
+	return pw.w.Write(rec)
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (pw *CSVPayoutWriter) Flush() error {
+	if err := pw.writeHeader(); err != nil {
+		return err
+	}
+
+	pw.w.Flush()
+	return pw.w.Error()
+}
+
+func (pw *CSVPayoutWriter) writeHeader() error {
+	if pw.header || len(pw.layout.Header) == 0 {
+		return nil
+	}
+
+	pw.header = true
+	return pw.w.Write(pw.layout.Header)
+}
+
+// FixedField is a field of a fixed-width payout layout. Values are padded with Pad, a space when zero,
+// up to Width bytes; they are left-aligned unless Right. Value is the text written by a FieldLiteral.
+type FixedField struct {
+	Field PayoutField
+	Width int
+	Pad   byte
+	Right bool
+	Value string
+}
+
+// FixedWidthLayout is the layout of a fixed-width payout file, such as the entry records of ACH files:
+// the fields of each record, the amount format and the record terminator, "\n" when empty.
+type FixedWidthLayout struct {
+	Fields     []FixedField
+	Amount     AmountFormat
+	LineEnding string
+}
+
+// FixedWidthPayoutWriter writes payouts as fixed-width records in a FixedWidthLayout.
+type FixedWidthPayoutWriter struct {
+	w      io.Writer
+	layout FixedWidthLayout
+	n      int
+}
+
+// NewFixedWidthPayoutWriter returns a writer of payouts in the fixed-width layout to w.
+func NewFixedWidthPayoutWriter(w io.Writer, layout FixedWidthLayout) *FixedWidthPayoutWriter {
+	return &FixedWidthPayoutWriter{w: w, layout: layout}
+}
+
+// Write validates the payout against the amount format and writes it as one record. Nothing is written
+// for an invalid payout, including values wider than their field, which fail with ErrFieldOverflow instead
+// of being truncated, and values holding control characters or fields with a non-ASCII pad, which fail with
+// ErrInvalidField; the error tells which payout of the file it is, counting from one.
+func (pw *FixedWidthPayoutWriter) Write(p Payout) error {
+	pw.n++
+	if err := pw.layout.Amount.Validate(p.Money); err != nil {
+		return payoutError(pw.n, p, err)
+	}
+
+	var b strings.Builder
+	for _, f := range pw.layout.Fields {
+		v := f.Value
+		if f.Field != FieldLiteral {
+			v = payoutValue(f.Field, p, pw.layout.Amount)
+		}
+
+		s, err := f.pad(v)
+		if err != nil {
+			return payoutError(pw.n, p, err)
+		}
+		b.WriteString(s)
+	}
+
+	end := pw.layout.LineEnding
+	if end == "" {
+		end = "\n"
+	}
+	b.WriteString(end)
+
+	_, err := io.WriteString(pw.w, b.String())
+	return err
+}
+
+// pad returns the value padded to the width of the field. Zero padding goes after the sign of negative numbers.
+func (f FixedField) pad(v string) (string, error) {
+	if err := checkField(v); err != nil {
+		return "", err
+	}
+
+	if f.Pad >= unicode.MaxASCII || f.Pad != 0 && unicode.IsControl(rune(f.Pad)) {
+		return "", fmt.Errorf("%w: pad byte %#x is not printable ASCII", ErrInvalidField, f.Pad)
+	}
+
+	if len(v) > f.Width {
+		return "", fmt.Errorf("%w: %q is longer than %d", ErrFieldOverflow, v, f.Width)
+	}
+
+	c := f.Pad
+	if c == 0 {
+		c = ' '
+	}
+	fill := strings.Repeat(string(c), f.Width-len(v))
+
+	switch {
+	case !f.Right:
+		return v + fill, nil
+	case c == '0' && strings.HasPrefix(v, "-"):
+		return "-" + fill + v[1:], nil
+	default:
+		return fill + v, nil
+	}
+}
+
+// payoutValue returns the text of the field for the payout.
+func payoutValue(f PayoutField, p Payout, af AmountFormat) string {
+	switch f {
+	case FieldRecipient:
+		return p.Recipient
+	case FieldAmount:
+		return af.Format(p.Money)
+	case FieldCurrency:
+		return p.Money.currency.Code
+	}
+
+	return ""
+}
+
+// checkField returns an error wrapping ErrInvalidField when the value holds a control character,
+// such as a line break starting a forged record.
+func checkField(v string) error {
+	for _, r := range v {
+		if unicode.IsControl(r) || r == '\u2028' || r == '\u2029' {
+			return fmt.Errorf("%w: %q holds a control character", ErrInvalidField, v)
+		}
+	}
+
+	return nil
+}
+
+func payoutError(n int, p Payout, err error) error {
+	return fmt.Errorf("payout %d to %q: %w", n, p.Recipient, err)
+}
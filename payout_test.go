@@ -0,0 +1,133 @@
+package money
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCSVPayoutWriter(t *testing.T) {
+	var b bytes.Buffer
+	w := NewCSVPayoutWriter(&b, CSVLayout{
+		Fields: []PayoutField{FieldRecipient, FieldAmount, FieldCurrency},
+		Header: []string{"recipient", "amount", "currency"},
+		Comma:  ';',
+		Amount: AmountFormat{Decimal: ","},
+	})
+
+	if err := w.Write(Payout{Recipient: "ACME; Inc", Money: New(123456, EUR)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(Payout{Recipient: "Yamada", Money: New(1500, JPY)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "recipient;amount;currency\n\"ACME; Inc\";1234,56;EUR\nYamada;1500;JPY\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q got %q", expected, b.String())
+	}
+}
+
+func TestFixedWidthPayoutWriter(t *testing.T) {
+	var b bytes.Buffer
+	w := NewFixedWidthPayoutWriter(&b, FixedWidthLayout{
+		Fields: []FixedField{
+			{Field: FieldLiteral, Width: 1, Value: "6"},
+			{Field: FieldAmount, Width: 10, Pad: '0', Right: true},
+			{Field: FieldRecipient, Width: 12},
+		},
+		Amount:     AmountFormat{ImpliedDecimal: true, Currency: "usd", AllowNegative: true},
+		LineEnding: "\r\n",
+	})
+
+	if err := w.Write(Payout{Recipient: "Jane Doe", Money: New(12345, USD)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(Payout{Recipient: "John Doe", Money: New(-500, USD)}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "60000012345Jane Doe    \r\n6-000000500John Doe    \r\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q got %q", expected, b.String())
+	}
+}
+
+func TestPayoutWriter_Invalid(t *testing.T) {
+	layout := FixedWidthLayout{
+		Fields: []FixedField{
+			{Field: FieldAmount, Width: 6, Pad: '0', Right: true},
+			{Field: FieldRecipient, Width: 8},
+		},
+		Amount: AmountFormat{ImpliedDecimal: true, Currency: USD},
+	}
+
+	tcs := []struct {
+		payout Payout
+		err    error
+	}{
+		{Payout{Recipient: "Jane", Money: New(100, EUR)}, ErrCurrencyMismatch},
+		{Payout{Recipient: "Jane", Money: New(-100, USD)}, ErrInvalidAmount},
+		{Payout{Recipient: "Jane", Money: NewFromDecimal(decimal.RequireFromString("1.005"), USD)}, ErrInvalidAmount},
+		{Payout{Recipient: "Jane"}, ErrInvalidAmount},
+		{Payout{Recipient: "Jane", Money: New(1234567, USD)}, ErrFieldOverflow},
+		{Payout{Recipient: "Jane Doe Jr", Money: New(100, USD)}, ErrFieldOverflow},
+		{Payout{Recipient: "A\n0099", Money: New(100, USD)}, ErrInvalidField},
+		{Payout{Recipient: "A\r", Money: New(100, USD)}, ErrInvalidField},
+		{Payout{Recipient: "A\x00", Money: New(100, USD)}, ErrInvalidField},
+		{Payout{Recipient: "A\u0085", Money: New(100, USD)}, ErrInvalidField},
+	}
+
+	for _, tc := range tcs {
+		var b bytes.Buffer
+		err := NewFixedWidthPayoutWriter(&b, layout).Write(tc.payout)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v got %v", tc.err, err)
+		}
+		if b.Len() != 0 {
+			t.Errorf("Expected nothing written for %+v got %q", tc.payout, b.String())
+		}
+	}
+
+	var b bytes.Buffer
+	w := NewCSVPayoutWriter(&b, CSVLayout{Fields: []PayoutField{FieldAmount}, Amount: layout.Amount})
+	_ = w.Write(Payout{Recipient: "Jane", Money: New(100, USD)})
+	err := w.Write(Payout{Recipient: "John", Money: New(100, EUR)})
+	if !errors.Is(err, ErrCurrencyMismatch) || err.Error() != `payout 2 to "John": currencies don't match: EUR payout, want USD` {
+		t.Errorf("Expected the second payout to fail got %v", err)
+	}
+}
+
+func TestPayoutWriter_ForgedRecord(t *testing.T) {
+	forged := Payout{Recipient: "ACME\nMALLORY   0099999999", Money: New(100, USD)}
+
+	var b bytes.Buffer
+	fw := NewFixedWidthPayoutWriter(&b, FixedWidthLayout{Fields: []FixedField{{Field: FieldRecipient, Width: 40}}})
+	if err := fw.Write(forged); !errors.Is(err, ErrInvalidField) || ErrorCode(err) != "money.invalid_field" {
+		t.Errorf("Expected ErrInvalidField got %v", err)
+	}
+
+	cw := NewCSVPayoutWriter(&b, CSVLayout{Fields: []PayoutField{FieldRecipient, FieldAmount}, Header: []string{"recipient", "amount"}})
+	if err := cw.Write(forged); !errors.Is(err, ErrInvalidField) {
+		t.Errorf("Expected ErrInvalidField got %v", err)
+	}
+	if err := cw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "recipient,amount\n" {
+		t.Errorf("Expected only the header got %q", b.String())
+	}
+
+	for _, pad := range []byte{0x80, 0xff, 0x7f, '\n'} {
+		b.Reset()
+		w := NewFixedWidthPayoutWriter(&b, FixedWidthLayout{Fields: []FixedField{{Field: FieldAmount, Width: 10, Pad: pad, Right: true}}})
+		if err := w.Write(Payout{Recipient: "Jane", Money: New(100, USD)}); !errors.Is(err, ErrInvalidField) || b.Len() != 0 {
+			t.Errorf("Expected ErrInvalidField for pad %#x got %v, %q", pad, err, b.String())
+		}
+	}
+}
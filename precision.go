@@ -0,0 +1,56 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// PrecisionError happens when an amount carries more decimal places than its currency allows,
+// such as sub-yen JPY amounts. It wraps ErrInvalidAmount.
+type PrecisionError struct {
+	Code     string
+	Fraction int
+	Amount   string
+}
+
+// Error implements error.
+func (e *PrecisionError) Error() string {
+	return fmt.Sprintf("%s amount %s has more than %d decimal places", e.Code, e.Amount, e.Fraction)
+}
+
+// Unwrap returns ErrInvalidAmount, so that errors.Is(err, ErrInvalidAmount) holds for precision errors.
+func (e *PrecisionError) Unwrap() error {
+	return ErrInvalidAmount
+}
+
+// ErrorCode implements CodedError.
+func (e *PrecisionError) ErrorCode() string {
+	return "money.precision_exceeded"
+}
+
+// CheckPrecision returns a *PrecisionError when Money carries precision beyond its currency fraction, nil otherwise.
+func (m *Money) CheckPrecision() error {
+	if m.amount.IsInteger() {
+		return nil
+	}
+
+	return &PrecisionError{Code: m.currency.Code, Fraction: m.currency.Fraction, Amount: m.Decimal().String()}
+}
+
+// NewFromDecimalStrict is like NewFromDecimal but rejects amounts with more decimal places than the currency
+// allows by returning a *PrecisionError, to catch upstream data bugs at ingestion time.
+func NewFromDecimalStrict(amount decimal.Decimal, code string) (*Money, error) {
+	m := NewFromDecimal(amount, code)
+	if err := m.CheckPrecision(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// NewFromFloatStrict is like NewFromFloat but rejects amounts with more decimal places than the currency
+// allows by returning a *PrecisionError instead of truncating them.
+func NewFromFloatStrict(amount float64, code string) (*Money, error) {
+	return NewFromDecimalStrict(decimal.NewFromFloat(amount), code)
+}
@@ -0,0 +1,90 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_CheckPrecision(t *testing.T) {
+	if err := New(1, JPY).CheckPrecision(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	err := NewFromDecimal(decimal.RequireFromString("1.5"), JPY).CheckPrecision()
+
+	var pe *PrecisionError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Expected *PrecisionError got %v", err)
+	}
+
+	if pe.Code != JPY || pe.Fraction != 0 || pe.Amount != "1.5" {
+		t.Errorf("Unexpected error fields %+v", pe)
+	}
+
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected error to wrap ErrInvalidAmount")
+	}
+
+	if err.Error() != "JPY amount 1.5 has more than 0 decimal places" {
+		t.Errorf("Unexpected error message %q", err.Error())
+	}
+}
+
+func TestNewFromDecimalStrict(t *testing.T) {
+	tcs := []struct {
+		amount string
+		code   string
+		minor  int64
+		valid  bool
+	}{
+		{"12.34", EUR, 1234, true},
+		{"12.3", EUR, 1230, true},
+		{"12.345", EUR, 0, false},
+		{"1200", JPY, 1200, true},
+		{"0.5", JPY, 0, false},
+		{"1.005", BHD, 1005, true},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromDecimalStrict(decimal.RequireFromString(tc.amount), tc.code)
+		if tc.valid != (err == nil) {
+			t.Errorf("Expected valid=%t for %s %s got %v", tc.valid, tc.amount, tc.code, err)
+			continue
+		}
+
+		if tc.valid && m.Amount() != tc.minor {
+			t.Errorf("Expected %d got %d", tc.minor, m.Amount())
+		}
+	}
+}
+
+func TestNewFromFloatStrict(t *testing.T) {
+	if _, err := NewFromFloatStrict(-0.125, EUR); !errors.As(err, new(*PrecisionError)) {
+		t.Errorf("Expected *PrecisionError got %v", err)
+	}
+
+	m, err := NewFromFloatStrict(-0.12, EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != -12 {
+		t.Errorf("Expected -12 got %d", m.Amount())
+	}
+}
+
+func TestParse_PrecisionError(t *testing.T) {
+	_, err := ParseWithCurrency("¥1.5", JPY)
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount got %v", err)
+	}
+
+	_, err = GetProfile("de-DE").Parse("1,234", EUR)
+
+	var pe *PrecisionError
+	if !errors.As(err, &pe) || pe.Code != EUR || pe.Amount != "1,234" {
+		t.Errorf("Expected *PrecisionError for EUR got %v", err)
+	}
+}
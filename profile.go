@@ -0,0 +1,94 @@
+package money
+
+import (
+	"encoding/csv"
+	"io"
+	"sync"
+)
+
+// Profile stores the number and field layout used by spreadsheet and CSV exports of a given locale.
+// AllowExponent makes Parse accept amounts in exponent notation such as "1,2e3", as emitted by some
+// scientific tools; the built-in profiles reject them.
+type Profile struct {
+	Name          string
+	Decimal       string
+	Thousand      string
+	Separator     rune
+	AllowExponent bool
+}
+
+// profilesMu guards profiles, so profiles can be added while Money is exported.
+var profilesMu sync.RWMutex
+
+// profiles represents a collection of export profiles, keyed by name.
+var profiles = map[string]*Profile{
+	"google-sheets": {Name: "google-sheets", Decimal: ".", Thousand: "", Separator: ','},
+	"en-US":         {Name: "en-US", Decimal: ".", Thousand: ",", Separator: ','},
+	"en-GB":         {Name: "en-GB", Decimal: ".", Thousand: ",", Separator: ','},
+	"de-DE":         {Name: "de-DE", Decimal: ",", Thousand: ".", Separator: ';'},
+	"de-CH":         {Name: "de-CH", Decimal: ".", Thousand: "'", Separator: ';'},
+	"es-ES":         {Name: "es-ES", Decimal: ",", Thousand: ".", Separator: ';'},
+	"fr-FR":         {Name: "fr-FR", Decimal: ",", Thousand: " ", Separator: ';'},
+	"it-IT":         {Name: "it-IT", Decimal: ",", Thousand: ".", Separator: ';'},
+	"nl-NL":         {Name: "nl-NL", Decimal: ",", Thousand: ".", Separator: ';'},
+	"pl-PL":         {Name: "pl-PL", Decimal: ",", Thousand: " ", Separator: ';'},
+	"pt-BR":         {Name: "pt-BR", Decimal: ",", Thousand: ".", Separator: ';'},
+	"sv-SE":         {Name: "sv-SE", Decimal: ",", Thousand: " ", Separator: ';'},
+}
+
+// AddProfile lets you insert or update a profile in the profiles list. It is safe for concurrent use.
+func AddProfile(name, Decimal, Thousand string, Separator rune, AllowExponent bool) *Profile {
+	p := Profile{
+		Name:          name,
+		Decimal:       Decimal,
+		Thousand:      Thousand,
+		Separator:     Separator,
+		AllowExponent: AllowExponent,
+	}
+
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+
+	profiles[name] = &p
+	return &p
+}
+
+// GetProfile returns the profile given its name, or nil if no such profile is registered.
+func GetProfile(name string) *Profile {
+	profilesMu.RLock()
+	defer profilesMu.RUnlock()
+
+	return profiles[name]
+}
+
+// Format returns the amount of Money as a plain number using the profile separators,
+// without any currency symbol, e.g. "1.234,56" for de-DE.
+func (p *Profile) Format(m *Money) string {
+	return NewFormatter(m.currency.Fraction, p.Decimal, p.Thousand, "", "1").Format(m.amount.IntPart())
+}
+
+// Parse parses a number written with the profile separators into Money of the given currency.
+// Thousand separators are optional; the number must not carry more decimal places than the currency allows.
+func (p *Profile) Parse(s, code string) (*Money, error) {
+	c := newCurrency(code).get()
+	amount, err := parseAmount(s, numberFormat{decimal: p.Decimal, thousand: p.Thousand, exponent: p.AllowExponent}, c.Code, c.Fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// NewReader returns a csv.Reader reading fields delimited by the profile separator.
+func (p *Profile) NewReader(r io.Reader) *csv.Reader {
+	cr := csv.NewReader(r)
+	cr.Comma = p.Separator
+	return cr
+}
+
+// NewWriter returns a csv.Writer writing fields delimited by the profile separator.
+func (p *Profile) NewWriter(w io.Writer) *csv.Writer {
+	cw := csv.NewWriter(w)
+	cw.Comma = p.Separator
+	return cw
+}
@@ -0,0 +1,165 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestProfile_Format(t *testing.T) {
+	tcs := []struct {
+		profile  string
+		amount   int64
+		code     string
+		expected string
+	}{
+		{"google-sheets", 123456, EUR, "1234.56"},
+		{"en-US", 123456, USD, "1,234.56"},
+		{"de-DE", 123456, EUR, "1.234,56"},
+		{"de-DE", -123456, EUR, "-1.234,56"},
+		{"fr-FR", 123456789, EUR, "1 234 567,89"},
+		{"de-CH", 123456, CHF, "1'234.56"},
+		{"de-DE", 1234, JPY, "1.234"},
+	}
+
+	for _, tc := range tcs {
+		r := GetProfile(tc.profile).Format(New(tc.amount, tc.code))
+
+		if r != tc.expected {
+			t.Errorf("Expected %s profile to format %d %s as %s got %s", tc.profile, tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
+
+func TestProfile_Parse(t *testing.T) {
+	tcs := []struct {
+		profile  string
+		input    string
+		code     string
+		expected int64
+	}{
+		{"google-sheets", "1234.56", EUR, 123456},
+		{"en-US", "1,234.56", USD, 123456},
+		{"en-US", "1234.5", USD, 123450},
+		{"de-DE", "1.234,56", EUR, 123456},
+		{"de-DE", "1234,56", EUR, 123456},
+		{"de-DE", " -0,01 ", EUR, -1},
+		{"de-DE", "+12", EUR, 1200},
+		{"fr-FR", "1 234 567,89", EUR, 123456789},
+		{"de-DE", "1.234", JPY, 1234},
+	}
+
+	for _, tc := range tcs {
+		m, err := GetProfile(tc.profile).Parse(tc.input, tc.code)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q with %s profile: %v", tc.input, tc.profile, err)
+			continue
+		}
+
+		if m.Amount() != tc.expected || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.expected, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestProfile_ParseInvalid(t *testing.T) {
+	tcs := []struct {
+		input string
+		code  string
+	}{
+		{"", EUR},
+		{"-", EUR},
+		{",", EUR},
+		{"abc", EUR},
+		{"12,345", EUR},
+		{"1,5", JPY},
+		{"1,2,3", EUR},
+		{"1e3", EUR},
+	}
+
+	p := GetProfile("de-DE")
+	for _, tc := range tcs {
+		_, err := p.Parse(tc.input, tc.code)
+		if !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected ErrInvalidAmount parsing %q got %v", tc.input, err)
+		}
+	}
+}
+
+func TestProfile_CSV(t *testing.T) {
+	p := GetProfile("de-DE")
+	b := &strings.Builder{}
+	w := p.NewWriter(b)
+	if err := w.Write([]string{"invoice-1", p.Format(New(123456, EUR)), EUR}); err != nil {
+		t.Fatal(err)
+	}
+	w.Flush()
+
+	expected := "invoice-1;1.234,56;EUR\n"
+	if b.String() != expected {
+		t.Errorf("Expected %q got %q", expected, b.String())
+	}
+
+	record, err := p.NewReader(strings.NewReader(b.String())).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(record, []string{"invoice-1", "1.234,56", "EUR"}) {
+		t.Errorf("Unexpected record %v", record)
+	}
+}
+
+func TestAddProfile(t *testing.T) {
+	AddProfile("test-profile", ",", "", '\t', true)
+	p := GetProfile("test-profile")
+
+	if p == nil || p.Decimal != "," || p.Separator != '\t' || !p.AllowExponent {
+		t.Errorf("Unexpected profile %+v", p)
+	}
+
+	if GetProfile("not-a-profile") != nil {
+		t.Error("Expected nil for unknown profile")
+	}
+}
+
+func TestAddProfile_Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			AddProfile(fmt.Sprintf("concurrent-%d", i), ",", ".", ';', false)
+		}(i)
+		go func() {
+			defer wg.Done()
+			GetProfile("de-DE").Format(New(123456, EUR))
+		}()
+	}
+	wg.Wait()
+
+	if GetProfile("concurrent-7") == nil {
+		t.Error("Expected the profiles added concurrently to be registered")
+	}
+}
+
+func TestProfile_ParseExponent(t *testing.T) {
+	p := *GetProfile("de-DE")
+
+	if _, err := p.Parse("1,2e3", EUR); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount got %v", err)
+	}
+
+	p.AllowExponent = true
+	m, err := p.Parse("1,2e3", EUR)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != 120000 {
+		t.Errorf("Expected 120000 got %d", m.Amount())
+	}
+}
@@ -0,0 +1,54 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// quantitySuffixes maps the shorthand suffixes accepted by ParseQuantity to their power of a thousand.
+var quantitySuffixes = map[byte]int{
+	'k': 1,
+	'K': 1,
+	'M': 2,
+	'G': 3,
+	'T': 4,
+}
+
+// ParseQuantity parses human-written configuration values such as "250 EUR", "1.5k EUR" or "2M USD"
+// into Money. The amount uses a dot decimal separator and may carry one of the suffixes
+// k (or K) for thousands, M for millions, G for billions and T for trillions, which are expanded exactly.
+// The expanded amount must not carry more decimal places than the currency allows.
+// Exponent notation such as "1.5e3 EUR" is always rejected.
+func ParseQuantity(s string) (*Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return nil, fmt.Errorf("%w: %q is not in \"<amount> <currency>\" form", ErrInvalidAmount, s)
+	}
+
+	num, code := fields[0], fields[1]
+
+	exp := 0
+	if p, ok := quantitySuffixes[num[len(num)-1]]; ok {
+		exp = 3 * p
+		num = num[:len(num)-1]
+	}
+
+	c := newCurrency(code).get()
+	amount, err := parseAmount(num, numberFormat{decimal: "."}, c.Code, c.Fraction+exp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// ExtendedPrice returns the price of qty units at the unit price, rounded once to whole minor units using the
+// given rounding mode, e.g. 2.5 kg at €0.99 per kg gives €2.48 with RoundHalfUp. The product is computed exactly
+// first, so the unit price may carry precision beyond the currency fraction, such as fuel at $3.199 per gallon.
+// A negative quantity, as in a return, gives a negative price; with symmetric modes such as RoundHalfUp and
+// RoundHalfEven it mirrors the price of the same positive quantity exactly.
+func ExtendedPrice(unit *Money, qty decimal.Decimal, rounding RoundingMode) *Money {
+	return unit.MultiplyDecimal(qty).RoundToCurrency(rounding)
+}
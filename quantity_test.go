@@ -0,0 +1,104 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tcs := []struct {
+		input    string
+		expected int64
+		code     string
+	}{
+		{"250 EUR", 25000, EUR},
+		{"1.5k EUR", 150000, EUR},
+		{"1.5K EUR", 150000, EUR},
+		{"2M USD", 200000000, USD},
+		{"-2.25M USD", -225000000, USD},
+		{"0.001k USD", 100, USD},
+		{"1.23456k USD", 123456, USD},
+		{"3G JPY", 3000000000, JPY},
+		{"1T JPY", 1000000000000, JPY},
+		{"  12.50   gbp ", 1250, GBP},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseQuantity(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.expected || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.expected, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestParseQuantity_Invalid(t *testing.T) {
+	for _, input := range []string{"", "EUR", "1.5k", "1.5x EUR", "k EUR", "1.234567k USD", "1.5 k EUR", "1,5k EUR", "0.1 JPY"} {
+		_, err := ParseQuantity(input)
+		if !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected ErrInvalidAmount parsing %q got %v", input, err)
+		}
+	}
+}
+
+func TestParseQuantity_RejectsExponent(t *testing.T) {
+	for _, input := range []string{"1e3 EUR", "1.5e3k EUR"} {
+		if _, err := ParseQuantity(input); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected ErrInvalidAmount parsing %q got %v", input, err)
+		}
+	}
+}
+
+func TestExtendedPrice(t *testing.T) {
+	tcs := []struct {
+		unit     string
+		qty      string
+		mode     RoundingMode
+		expected int64
+	}{
+		{"19.99", "3", RoundHalfUp, 5997},
+		{"0.99", "2.5", RoundHalfUp, 248},
+		{"0.99", "-2.5", RoundHalfUp, -248},
+		{"0.99", "2.5", RoundHalfEven, 248},
+		{"0.99", "-2.5", RoundHalfEven, -248},
+		{"0.99", "2.5", RoundHalfDown, 247},
+		{"0.99", "2.5", RoundTruncate, 247},
+		{"0.99", "-2.5", RoundFloor, -248},
+		{"0.99", "-2.5", RoundCeiling, -247},
+		{"10.00", "0.333", RoundHalfUp, 333},
+		{"3.199", "12.5", RoundHalfUp, 3999},
+		{"3.199", "0", RoundHalfUp, 0},
+		{"-5.00", "2", RoundHalfUp, -1000},
+	}
+
+	for _, tc := range tcs {
+		unit := NewFromDecimal(decimal.RequireFromString(tc.unit), USD)
+		m := ExtendedPrice(unit, decimal.RequireFromString(tc.qty), tc.mode)
+
+		if m.Amount() != tc.expected || !m.amount.IsInteger() || m.Currency().Code != USD {
+			t.Errorf("Expected %s x %s with %s to be %d got %s", tc.qty, tc.unit, tc.mode, tc.expected, m.amount)
+		}
+	}
+}
+
+func TestExtendedPrice_ReturnsMirrorSales(t *testing.T) {
+	unit := NewFromDecimal(decimal.RequireFromString("0.37"), EUR)
+
+	for q := int64(1); q <= 1000; q++ {
+		qty := decimal.New(q, -2)
+		for _, mode := range []RoundingMode{RoundHalfUp, RoundHalfDown, RoundHalfEven, RoundTruncate} {
+			sale := ExtendedPrice(unit, qty, mode)
+			ret := ExtendedPrice(unit, qty.Neg(), mode)
+
+			if sale.Amount() != -ret.Amount() {
+				t.Fatalf("Expected the return of %s to mirror the sale with %s got %d and %d", qty, mode, sale.Amount(), ret.Amount())
+			}
+		}
+	}
+}
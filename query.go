@@ -0,0 +1,88 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryValue returns Money in the compact form used in URL query parameters: its MachineString followed by
+// the currency code, e.g. "1234.56EUR". Like MarshalText, it returns ErrLossyConversion for Money carrying
+// precision beyond the currency fraction, which ParseQueryValue couldn't read back.
+func (m *Money) QueryValue() (string, error) {
+	if !m.amount.IsInteger() {
+		return "", ErrLossyConversion
+	}
+
+	return m.MachineString() + m.currency.Code, nil
+}
+
+// ParseQueryValue parses a price written in a URL query parameter into Money. It accepts the compact form
+// returned by QueryValue, "1234.56EUR", and the code-first form "EUR:1234.56". The amount uses a dot as decimal
+// separator without thousand separators, and the currency must be registered.
+func ParseQueryValue(s string) (*Money, error) {
+	code, num := "", strings.TrimSpace(s)
+	if i := strings.Index(num, ":"); i >= 0 {
+		code, num = num[:i], num[i+1:]
+	} else {
+		i := len(num)
+		for i > 0 && isLetter(num[i-1]) {
+			i--
+		}
+		num, code = num[:i], num[i:]
+	}
+
+	c := GetCurrency(strings.TrimSpace(code))
+	if c == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCurrency, s)
+	}
+
+	amount, err := parseAmount(num, numberFormat{decimal: "."}, c.Code, c.Fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Money{amount: amount, currency: c}, nil
+}
+
+// MarshalText is implementation of encoding.TextMarshaler, writing the MachineString and the currency code
+// separated by a space, e.g. "1234.56 EUR". The zero value of Money is written as empty text. Money carrying
+// precision beyond the currency fraction returns ErrLossyConversion, as the text form can't hold it, and Money
+// in a currency without code returns ErrEmptyCurrencyCode.
+func (m Money) MarshalText() ([]byte, error) {
+	if m.currency == nil {
+		return []byte{}, nil
+	}
+
+	if !m.amount.IsInteger() {
+		return nil, ErrLossyConversion
+	}
+
+	if m.currency.Code == "" {
+		return nil, ErrEmptyCurrencyCode
+	}
+
+	return []byte(m.MachineString() + " " + m.currency.Code), nil
+}
+
+// UnmarshalText is implementation of encoding.TextUnmarshaler, accepting the form written by MarshalText
+// as well as those of ParseQueryValue.
+// Empty text sets the zero value of Money.
+func (m *Money) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		*m = Money{}
+		return nil
+	}
+
+	parsed, err := ParseQueryValue(string(b))
+	if err != nil {
+		return err
+	}
+
+	*m = *parsed
+	return nil
+}
+
+// isLetter reports whether b is an ASCII letter.
+func isLetter(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
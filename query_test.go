@@ -0,0 +1,128 @@
+package money
+
+import (
+	"encoding"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	_ encoding.TextMarshaler   = Money{}
+	_ encoding.TextUnmarshaler = &Money{}
+)
+
+func TestMoney_QueryValue(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456, EUR, "1234.56EUR"},
+		{-5, USD, "-0.05USD"},
+		{1234, JPY, "1234JPY"},
+	}
+
+	for _, tc := range tcs {
+		if r, err := New(tc.amount, tc.code).QueryValue(); err != nil || r != tc.expected {
+			t.Errorf("Expected %s got %s %v", tc.expected, r, err)
+		}
+	}
+
+	m := &Money{amount: decimal.RequireFromString("319.9"), currency: newCurrency(EUR).get()}
+	if r, err := m.QueryValue(); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %q %v", ErrLossyConversion, r, err)
+	}
+}
+
+func TestParseQueryValue(t *testing.T) {
+	tcs := []struct {
+		input  string
+		amount int64
+		code   string
+	}{
+		{"1234.56EUR", 123456, EUR},
+		{"EUR:1234.56", 123456, EUR},
+		{"eur:1234.5", 123450, EUR},
+		{"-0.05USD", -5, USD},
+		{"1234JPY", 1234, JPY},
+		{"10 GBP", 1000, GBP},
+	}
+
+	for _, tc := range tcs {
+		m, err := ParseQueryValue(tc.input)
+		if err != nil {
+			t.Errorf("Unexpected error parsing %q: %v", tc.input, err)
+			continue
+		}
+
+		if m.Amount() != tc.amount || m.Currency().Code != tc.code {
+			t.Errorf("Expected %q to parse into %d %s got %d %s", tc.input, tc.amount, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestParseQueryValue_Errors(t *testing.T) {
+	tcs := []struct {
+		input string
+		err   error
+	}{
+		{"1234.56", ErrUnknownCurrency},
+		{"1234.56XXX", ErrUnknownCurrency},
+		{"EUR:", ErrInvalidAmount},
+		{"1,234.56EUR", ErrInvalidAmount},
+		{"1234.567EUR", ErrInvalidAmount},
+		{"1e3EUR", ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		if _, err := ParseQueryValue(tc.input); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %q to fail with %v got %v", tc.input, tc.err, err)
+		}
+	}
+}
+
+func TestMoney_TextRoundTrip(t *testing.T) {
+	q := url.Values{}
+	b, err := New(-123456, EUR).MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Set("price", string(b))
+
+	values, err := url.ParseQuery(q.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m Money
+	if err := m.UnmarshalText([]byte(values.Get("price"))); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Amount() != -123456 || m.Currency().Code != EUR {
+		t.Errorf("Expected %d %s got %d %s", -123456, EUR, m.Amount(), m.Currency().Code)
+	}
+
+	if b, err := New(12345, USD).MarshalText(); err != nil || string(b) != "123.45 USD" {
+		t.Errorf("Expected %q got %q %v", "123.45 USD", b, err)
+	}
+
+	if b, err := (Money{}).MarshalText(); err != nil || len(b) != 0 {
+		t.Errorf("Expected empty text for zero Money got %q %v", b, err)
+	}
+
+	if err := m.UnmarshalText(nil); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero Money from empty text got %v %v", m, err)
+	}
+
+	if _, err := NewFromDecimal(decimal.RequireFromString("3.199"), USD).MarshalText(); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, err)
+	}
+
+	if _, err := New(0, "").MarshalText(); !errors.Is(err, ErrEmptyCurrencyCode) {
+		t.Errorf("Expected %v got %v", ErrEmptyCurrencyCode, err)
+	}
+}
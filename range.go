@@ -0,0 +1,109 @@
+package money
+
+import "encoding/json"
+
+// ErrInvalidRange happens when the minimum of a MoneyRange is greater than its maximum.
+var ErrInvalidRange = newError("money.invalid_range", "range minimum is greater than its maximum")
+
+// MoneyRange is an inclusive range of Money between Min and Max, in a single currency,
+// such as the minimum and maximum order total a shop accepts.
+type MoneyRange struct {
+	Min *Money
+	Max *Money
+}
+
+// NewMoneyRange creates a MoneyRange from min to max, both included. It returns ErrCurrencyMismatch when
+// they are in different currencies and ErrInvalidRange when min is greater than max.
+func NewMoneyRange(min, max *Money) (*MoneyRange, error) {
+	r := &MoneyRange{Min: min, Max: max}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Currency returns the currency of the range.
+func (r *MoneyRange) Currency() *Currency {
+	return r.Min.currency
+}
+
+// Contains checks whether Money lies within the range, bounds included.
+// It returns ErrCurrencyMismatch when Money is in another currency.
+func (r *MoneyRange) Contains(m *Money) (bool, error) {
+	if err := r.Min.assertSameCurrency(m); err != nil {
+		return false, err
+	}
+
+	return m.compare(r.Min) >= 0 && m.compare(r.Max) <= 0, nil
+}
+
+// Clamp returns Money limited to the range: Min when it is below, Max when it is above, and Money itself otherwise.
+// It returns ErrCurrencyMismatch when Money is in another currency.
+func (r *MoneyRange) Clamp(m *Money) (*Money, error) {
+	if err := r.Min.assertSameCurrency(m); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case m.compare(r.Min) < 0:
+		return r.Min, nil
+	case m.compare(r.Max) > 0:
+		return r.Max, nil
+	}
+
+	return m, nil
+}
+
+// Overlaps checks whether the range shares at least one amount with the other range.
+// It returns ErrCurrencyMismatch when the ranges are in different currencies.
+func (r *MoneyRange) Overlaps(or *MoneyRange) (bool, error) {
+	if err := r.Min.assertSameCurrency(or.Min); err != nil {
+		return false, err
+	}
+
+	return r.Min.compare(or.Max) <= 0 && or.Min.compare(r.Max) <= 0, nil
+}
+
+// jsonRange is the JSON shape of a MoneyRange, with both bounds encoded like Money.
+type jsonRange struct {
+	Min *Money `json:"min"`
+	Max *Money `json:"max"`
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (r MoneyRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonRange{Min: r.Min, Max: r.Max})
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller. The decoded range is validated like in NewMoneyRange.
+func (r *MoneyRange) UnmarshalJSON(b []byte) error {
+	var v jsonRange
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	if v.Min == nil || v.Max == nil || v.Min.currency == nil || v.Max.currency == nil {
+		return ErrInvalidJSONUnmarshal
+	}
+
+	nr := MoneyRange{Min: v.Min, Max: v.Max}
+	if err := nr.validate(); err != nil {
+		return err
+	}
+
+	*r = nr
+	return nil
+}
+
+func (r *MoneyRange) validate() error {
+	if err := r.Min.assertSameCurrency(r.Max); err != nil {
+		return err
+	}
+
+	if r.Min.compare(r.Max) > 0 {
+		return ErrInvalidRange
+	}
+
+	return nil
+}
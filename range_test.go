@@ -0,0 +1,162 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewMoneyRange(t *testing.T) {
+	if _, err := NewMoneyRange(New(100, EUR), New(100, EUR)); err != nil {
+		t.Errorf("Expected no error got %v", err)
+	}
+
+	if _, err := NewMoneyRange(New(200, EUR), New(100, EUR)); err != ErrInvalidRange {
+		t.Errorf("Expected %v got %v", ErrInvalidRange, err)
+	}
+
+	if _, err := NewMoneyRange(New(100, EUR), New(200, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoneyRange_Contains(t *testing.T) {
+	r, err := NewMoneyRange(New(100, EUR), New(500, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		amount   int64
+		expected bool
+	}{
+		{99, false},
+		{100, true},
+		{300, true},
+		{500, true},
+		{501, false},
+	}
+
+	for _, tc := range tcs {
+		ok, err := r.Contains(New(tc.amount, EUR))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ok != tc.expected {
+			t.Errorf("Expected %d in range to be %t got %t", tc.amount, tc.expected, ok)
+		}
+	}
+
+	if _, err := r.Contains(New(300, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoneyRange_Clamp(t *testing.T) {
+	r, err := NewMoneyRange(New(100, EUR), New(500, EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		amount   int64
+		expected int64
+	}{
+		{-50, 100},
+		{100, 100},
+		{300, 300},
+		{900, 500},
+	}
+
+	for _, tc := range tcs {
+		m, err := r.Clamp(New(tc.amount, EUR))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.Amount() != tc.expected {
+			t.Errorf("Expected %d got %d", tc.expected, m.Amount())
+		}
+	}
+
+	if _, err := r.Clamp(New(300, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoneyRange_Overlaps(t *testing.T) {
+	r, _ := NewMoneyRange(New(100, EUR), New(500, EUR))
+
+	tcs := []struct {
+		min, max int64
+		expected bool
+	}{
+		{0, 99, false},
+		{0, 100, true},
+		{200, 300, true},
+		{500, 900, true},
+		{501, 900, false},
+		{0, 900, true},
+	}
+
+	for _, tc := range tcs {
+		or, _ := NewMoneyRange(New(tc.min, EUR), New(tc.max, EUR))
+		ok, err := r.Overlaps(or)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ok != tc.expected {
+			t.Errorf("Expected %d-%d to overlap %t got %t", tc.min, tc.max, tc.expected, ok)
+		}
+	}
+
+	or, _ := NewMoneyRange(New(100, USD), New(500, USD))
+	if _, err := r.Overlaps(or); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected %v got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoneyRange_JSON(t *testing.T) {
+	MarshalJSON, UnmarshalJSON = defaultMarshalJSON, defaultUnmarshalJSON
+
+	r, _ := NewMoneyRange(New(100, EUR), New(500, EUR))
+
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"min":{"amount":100,"currency":"EUR"},"max":{"amount":500,"currency":"EUR"}}`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, b)
+	}
+
+	var ur MoneyRange
+	if err := json.Unmarshal(b, &ur); err != nil {
+		t.Fatal(err)
+	}
+
+	if ur.Min.Amount() != 100 || ur.Max.Amount() != 500 || ur.Currency().Code != EUR {
+		t.Errorf("Expected %s got %s", expected, b)
+	}
+
+	tcs := []struct {
+		input    string
+		expected error
+	}{
+		{`{"min":{"amount":500,"currency":"EUR"},"max":{"amount":100,"currency":"EUR"}}`, ErrInvalidRange},
+		{`{"min":{"amount":100,"currency":"EUR"},"max":{"amount":500,"currency":"USD"}}`, ErrCurrencyMismatch},
+		{`{"min":{"amount":100,"currency":"EUR"}}`, ErrInvalidJSONUnmarshal},
+		{`{"min":{},"max":{"amount":5,"currency":"EUR"}}`, ErrInvalidJSONUnmarshal},
+		{`{"min":{"amount":5,"currency":"EUR"},"max":{}}`, ErrInvalidJSONUnmarshal},
+	}
+
+	for _, tc := range tcs {
+		var r MoneyRange
+		if err := json.Unmarshal([]byte(tc.input), &r); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v got %v for %s", tc.expected, err, tc.input)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package money
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrEmptyCurrencyCode happens when registering a currency without a code.
+	ErrEmptyCurrencyCode = newError("money.empty_currency_code", "currency code must not be empty")
+
+	// ErrRegistryFrozen happens when changing a registry after Freeze.
+	ErrRegistryFrozen = newError("money.registry_frozen", "registry is frozen")
+)
+
+// Registry is a set of currencies that is safe for concurrent use.
+// Each Registry is independent, so currencies added to one, such as a tenant's custom "points" currency,
+// are not visible through another. Money constructed from a Registry carries its currency with it,
+// so it formats and compares the same way wherever it is used.
+type Registry struct {
+	mu         sync.RWMutex
+	currencies Currencies
+	// defaults interns the default currencies handed out for unregistered codes,
+	// so Money in the same unknown currency shares one instance.
+	defaults Currencies
+	// trading holds the tick and lot sizes of currencies and instruments, see SetTradingRules.
+	trading map[string]TradingRules
+	// frozen rejects any change to the currencies and trading rules, see Freeze.
+	frozen bool
+}
+
+// maxDefaultCurrencies bounds how many unregistered codes a registry interns, so that untrusted input
+// such as JSON payloads can't grow it forever. Codes beyond the limit get a fresh default currency each time.
+const maxDefaultCurrencies = 1024
+
+// DefaultRegistry is the registry used by the package-level functions, such as New, AddCurrency and GetCurrency.
+var DefaultRegistry = &Registry{currencies: currencies}
+
+// builtinCurrencies keeps a pristine copy of the ISO 4217 currencies to seed new registries with.
+var builtinCurrencies = currencies.clone()
+
+// NewRegistry creates a new Registry holding the ISO 4217 currencies, regardless of any currency
+// added to the DefaultRegistry.
+func NewRegistry() *Registry {
+	return &Registry{currencies: builtinCurrencies.clone()}
+}
+
+// AddCurrency lets you insert or update currency in the registry.
+// Its symbol and separators are stored in Unicode NFC. The ISO 4217 numeric code is optional.
+// It returns ErrRegistryFrozen once the registry is frozen.
+func (r *Registry) AddCurrency(code, Grapheme, Template, Decimal, Thousand string, Fraction int, NumericCode ...string) (*Currency, error) {
+	if code == "" {
+		return nil, ErrEmptyCurrencyCode
+	}
+
+	c := Currency{
+		Code:        code,
+		NumericCode: numericCode(NumericCode),
+		Grapheme:    Grapheme,
+		Template:    Template,
+		Decimal:     Decimal,
+		Thousand:    Thousand,
+		Fraction:    Fraction,
+	}
+	return r.add(&c)
+}
+
+// Freeze makes the registry read-only: afterwards AddCurrency and SetTradingRules return ErrRegistryFrozen,
+// so currency behaviour can't drift at runtime, e.g. because of a stray registration in a dependency.
+// Freeze it once at start-up, after registering custom currencies. A registry can't be unfrozen.
+//
+// Freeze guards the registry, not the currencies themselves: GetCurrency and Money.Currency return the shared
+// *Currency, whose exported fields can still be assigned. Treat them as read-only.
+func (r *Registry) Freeze() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.frozen = true
+}
+
+// Frozen reports whether the registry was frozen with Freeze.
+func (r *Registry) Frozen() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.frozen
+}
+
+// GetCurrency returns the currency given the code, or nil if it isn't registered.
+func (r *Registry) GetCurrency(code string) *Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.currencies.CurrencyByCode(strings.ToUpper(code))
+}
+
+// GetCurrencyByNumericCode returns the currency given the ISO 4217 numeric code, or nil if it isn't registered.
+func (r *Registry) GetCurrencyByNumericCode(code string) *Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.currencies.CurrencyByNumericCode(code)
+}
+
+// Currencies returns the currencies of the registry, ordered by code.
+func (r *Registry) Currencies() []*Currency {
+	return r.sorted()
+}
+
+// New creates and returns new instance of Money in a currency of the registry.
+// Like the package-level New, unknown currency codes get a default two-decimal currency.
+func (r *Registry) New(amount int64, code string) *Money {
+	return &Money{
+		amount:   decimal.NewFromInt(amount),
+		currency: r.get(code),
+	}
+}
+
+// NewFromDecimal creates and returns new instance of Money in a currency of the registry
+// from a decimal amount of major units, see NewFromDecimal.
+func (r *Registry) NewFromDecimal(amount decimal.Decimal, code string) *Money {
+	currency := r.get(code)
+	return &Money{
+		amount:   amount.Shift(int32(currency.Fraction)),
+		currency: currency,
+	}
+}
+
+// NewFromString creates and returns new instance of Money in a currency of the registry from a string holding
+// an exact amount of major units, see NewFromString.
+func (r *Registry) NewFromString(s, code string, mode ...RoundingMode) (*Money, error) {
+	currency := r.get(code)
+	d, err := parseNumber(s, numberFormat{decimal: "."})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Money{amount: d.Shift(int32(currency.Fraction)), currency: currency}
+	if len(mode) > 0 {
+		return m.RoundToCurrency(mode...), nil
+	}
+
+	if err := m.CheckPrecision(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// NewFromNumericCode creates and returns new instance of Money in the currency of the registry with
+// the given ISO 4217 numeric code, such as "840" for USD. Unlike New it returns ErrUnknownCurrency
+// when no registered currency has the code.
+func (r *Registry) NewFromNumericCode(amount int64, code string) (*Money, error) {
+	c := r.GetCurrencyByNumericCode(code)
+	if c == nil {
+		return nil, fmt.Errorf("%w: numeric code %q", ErrUnknownCurrency, code)
+	}
+
+	return &Money{amount: decimal.NewFromInt(amount), currency: c}, nil
+}
+
+func (r *Registry) add(c *Currency) (*Currency, error) {
+	c.Grapheme, c.Template = normalize(c.Grapheme), normalize(c.Template)
+	c.Decimal, c.Thousand = normalize(c.Decimal), normalize(c.Thousand)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.frozen {
+		return nil, fmt.Errorf("%w: can't add currency %s", ErrRegistryFrozen, c.Code)
+	}
+
+	r.currencies.Add(c)
+	delete(r.defaults, c.Code)
+	return c, nil
+}
+
+// get returns the registered currency for code, or a default one if it isn't registered.
+// Either way the same code always yields the same shared instance.
+func (r *Registry) get(code string) *Currency {
+	code = strings.ToUpper(code)
+
+	r.mu.RLock()
+	c := r.currencies.CurrencyByCode(code)
+	if c == nil {
+		c = r.defaults.CurrencyByCode(code)
+	}
+	r.mu.RUnlock()
+
+	if c != nil {
+		return c
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c := r.currencies.CurrencyByCode(code); c != nil {
+		return c
+	}
+
+	if c := r.defaults.CurrencyByCode(code); c != nil {
+		return c
+	}
+
+	c = newCurrency(code).getDefault()
+	if len(r.defaults) < maxDefaultCurrencies {
+		if r.defaults == nil {
+			r.defaults = Currencies{}
+		}
+
+		r.defaults.Add(c)
+	}
+
+	return c
+}
+
+// sorted returns the registered currencies ordered by code.
+func (r *Registry) sorted() []*Currency {
+	r.mu.RLock()
+	cs := make([]*Currency, 0, len(r.currencies))
+	for _, c := range r.currencies {
+		cs = append(cs, c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].Code < cs[j].Code
+	})
+	return cs
+}
+
+// clone returns a deep copy of the currencies.
+func (c Currencies) clone() Currencies {
+	cs := make(Currencies, len(c))
+	for code, currency := range c {
+		cp := *currency
+		cs[code] = &cp
+	}
+
+	return cs
+}
@@ -0,0 +1,234 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRegistry_Isolation(t *testing.T) {
+	a := NewRegistry()
+	b := NewRegistry()
+
+	if _, err := a.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if a.GetCurrency("PTS") == nil {
+		t.Error("expected PTS to be registered in a")
+	}
+
+	if b.GetCurrency("PTS") != nil {
+		t.Error("expected PTS not to be registered in b")
+	}
+
+	if GetCurrency("PTS") != nil {
+		t.Error("expected PTS not to be registered in the DefaultRegistry")
+	}
+
+	if _, err := a.AddCurrency(EUR, "E", "$1", ".", ",", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	if c := b.GetCurrency(EUR); c.Fraction != 2 {
+		t.Errorf("Expected EUR fraction in b %d got %d", 2, c.Fraction)
+	}
+
+	if c := GetCurrency(EUR); c.Fraction != 2 {
+		t.Errorf("Expected EUR fraction in DefaultRegistry %d got %d", 2, c.Fraction)
+	}
+}
+
+func TestRegistry_AddCurrencyEmptyCode(t *testing.T) {
+	if _, err := NewRegistry().AddCurrency("", "$", "$1", ".", ",", 2); !errors.Is(err, ErrEmptyCurrencyCode) {
+		t.Errorf("Expected %v got %v", ErrEmptyCurrencyCode, err)
+	}
+}
+
+func TestRegistry_New(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	m := r.New(1500, "pts")
+	if m.Display() != "1,500 pts" {
+		t.Errorf("Expected %s got %s", "1,500 pts", m.Display())
+	}
+
+	m = r.NewFromDecimal(decimal.RequireFromString("12"), "PTS")
+	if m.Amount() != 12 {
+		t.Errorf("Expected %d got %d", 12, m.Amount())
+	}
+
+	// Money built from a registry keeps its currency when used elsewhere.
+	if s := New(0, "PTS").Display(); s == m.Display() {
+		t.Errorf("Expected DefaultRegistry not to know PTS, got %s", s)
+	}
+
+	if eq, err := r.New(12, "PTS").Equals(m); err != nil || !eq {
+		t.Errorf("Expected equal amounts, got %v %v", eq, err)
+	}
+}
+
+func TestRegistry_Parser(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Parser{Registry: r}
+	m, err := p.Parse("1,500 pts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Currency().Code != "PTS" || m.Amount() != 1500 {
+		t.Errorf("Expected %d %s got %d %s", 1500, "PTS", m.Amount(), m.Currency().Code)
+	}
+
+	if _, err := Parse("1,500 pts"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected %v got %v", ErrUnknownCurrency, err)
+	}
+
+	if err := r.VerifyDisplayRoundTrip("PTS"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	r := NewRegistry()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			code := fmt.Sprintf("X%02d", i)
+			for j := 0; j < 100; j++ {
+				if _, err := r.AddCurrency(code, "x", "1 $", ".", ",", 2); err != nil {
+					t.Error(err)
+					return
+				}
+
+				if r.GetCurrency(code) == nil {
+					t.Errorf("expected %s to be registered", code)
+				}
+
+				r.New(int64(j), EUR).Display()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	if DefaultRegistry.GetCurrency(USD) != GetCurrency(USD) {
+		t.Error("expected the package-level GetCurrency to use the DefaultRegistry")
+	}
+
+	if GetCurrencyByNumericCode("978") != DefaultRegistry.GetCurrencyByNumericCode("978") {
+		t.Error("expected the package-level GetCurrencyByNumericCode to use the DefaultRegistry")
+	}
+}
+
+func TestRegistry_SharedCurrency(t *testing.T) {
+	r := NewRegistry()
+
+	if r.New(1, EUR).Currency() != r.New(2, "eur").Currency() {
+		t.Error("expected Money in the same currency to share its Currency")
+	}
+
+	unknown := r.New(1, "ABC").Currency()
+	if unknown != r.New(2, "abc").Currency() {
+		t.Error("expected Money in the same unknown currency to share its Currency")
+	}
+
+	registered, err := r.AddCurrency("ABC", "abc", "1 $", ".", ",", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c := r.New(3, "ABC").Currency(); c != registered || c == unknown {
+		t.Errorf("expected the registered currency to replace the default one, got %+v", c)
+	}
+}
+
+func TestRegistry_SharedCurrencyLimit(t *testing.T) {
+	r := NewRegistry()
+
+	for i := 0; i < maxDefaultCurrencies+10; i++ {
+		r.New(1, fmt.Sprintf("Z%05d", i))
+	}
+
+	if len(r.defaults) != maxDefaultCurrencies {
+		t.Errorf("expected %d interned default currencies got %d", maxDefaultCurrencies, len(r.defaults))
+	}
+
+	if c := r.New(1, "Z00010").Currency(); c.Code != "Z00010" || c.Fraction != 2 {
+		t.Errorf("expected a default currency got %+v", c)
+	}
+}
+
+func TestRegistry_Freeze(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	r.Freeze()
+	if !r.Frozen() {
+		t.Error("Expected the registry to be frozen")
+	}
+
+	if _, err := r.AddCurrency("PTS", "P", "$1", ".", ",", 2); !errors.Is(err, ErrRegistryFrozen) {
+		t.Errorf("Expected ErrRegistryFrozen got %v", err)
+	}
+	if _, err := r.AddCurrency(EUR, "E", "$1", ".", ",", 3); !errors.Is(err, ErrRegistryFrozen) {
+		t.Errorf("Expected ErrRegistryFrozen got %v", err)
+	}
+	if err := r.SetTradingRules(EUR, TradingRules{Tick: decimal.RequireFromString("0.05")}); !errors.Is(err, ErrRegistryFrozen) {
+		t.Errorf("Expected ErrRegistryFrozen got %v", err)
+	}
+
+	if c := r.GetCurrency("PTS"); c.Grapheme != "pts" || c.Fraction != 0 {
+		t.Errorf("Expected the currency to be unchanged got %+v", c)
+	}
+	if c := r.GetCurrency(EUR); c.Fraction != 2 {
+		t.Errorf("Expected the currency to be unchanged got %+v", c)
+	}
+	if _, ok := r.TradingRules(EUR); ok {
+		t.Error("Expected no trading rules")
+	}
+
+	if m := r.New(100, "XYZ"); m.Currency().Code != "XYZ" {
+		t.Errorf("Expected unregistered codes to keep working got %+v", m.Currency())
+	}
+}
+
+func TestDefaultRegistry_Freeze(t *testing.T) {
+	defer func(r *Registry) { DefaultRegistry = r }(DefaultRegistry)
+	DefaultRegistry = NewRegistry()
+	DefaultRegistry.Freeze()
+
+	func() {
+		defer func() {
+			if err, ok := recover().(error); !ok || !errors.Is(err, ErrRegistryFrozen) {
+				t.Errorf("Expected a panic with ErrRegistryFrozen got %v", err)
+			}
+		}()
+
+		AddCurrency(EUR, "E", "$1", ".", ",", 3)
+	}()
+	if c := GetCurrency(EUR); c.Fraction != 2 {
+		t.Errorf("Expected the currency to be unchanged got %+v", c)
+	}
+	if err := SetTradingRules(EUR, TradingRules{}); !errors.Is(err, ErrRegistryFrozen) {
+		t.Errorf("Expected ErrRegistryFrozen got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package money
+
+// RoundingMode selects how a value is rounded when it has to lose precision.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest value, ties away from zero. It is the default rounding mode.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest value, ties towards zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest value, ties to the even neighbour (banker's rounding).
+	RoundHalfEven
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundTruncate rounds towards zero.
+	RoundTruncate
+)
+
+var roundingModeNames = map[RoundingMode]string{
+	RoundHalfUp:   "half-up",
+	RoundHalfDown: "half-down",
+	RoundHalfEven: "half-even",
+	RoundFloor:    "floor",
+	RoundCeiling:  "ceiling",
+	RoundTruncate: "truncate",
+}
+
+// String returns the name of the rounding mode, e.g. "half-even".
+func (r RoundingMode) String() string {
+	if name, ok := roundingModeNames[r]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// roundingMode returns the first of the optional modes, or RoundHalfUp when none is given.
+func roundingMode(modes []RoundingMode) RoundingMode {
+	if len(modes) == 0 {
+		return RoundHalfUp
+	}
+
+	return modes[0]
+}
@@ -0,0 +1,48 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRoundingMode(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		expected [6]int64 // HalfUp, HalfDown, HalfEven, Floor, Ceiling, Truncate
+	}{
+		{"2.5", [6]int64{3, 2, 2, 2, 3, 2}},
+		{"3.5", [6]int64{4, 3, 4, 3, 4, 3}},
+		{"-2.5", [6]int64{-3, -2, -2, -3, -2, -2}},
+		{"-3.5", [6]int64{-4, -3, -4, -4, -3, -3}},
+		{"2.4", [6]int64{2, 2, 2, 2, 3, 2}},
+		{"2.6", [6]int64{3, 3, 3, 2, 3, 2}},
+		{"-2.6", [6]int64{-3, -3, -3, -3, -2, -2}},
+		{"2", [6]int64{2, 2, 2, 2, 2, 2}},
+		{"0.0001", [6]int64{0, 0, 0, 0, 1, 0}},
+		{"-0.0001", [6]int64{0, 0, 0, -1, 0, 0}},
+	}
+
+	modes := []RoundingMode{RoundHalfUp, RoundHalfDown, RoundHalfEven, RoundFloor, RoundCeiling, RoundTruncate}
+	for _, tc := range tcs {
+		m := &Money{amount: decimal.RequireFromString(tc.amount), currency: newCurrency(EUR).get()}
+
+		for i, mode := range modes {
+			r := m.RoundToCurrency(mode).amount
+
+			if !r.Equal(decimal.NewFromInt(tc.expected[i])) {
+				t.Errorf("Expected %s rounded %s to be %d got %s", tc.amount, mode, tc.expected[i], r)
+			}
+		}
+	}
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	if RoundHalfEven.String() != "half-even" {
+		t.Errorf("Expected half-even got %s", RoundHalfEven)
+	}
+
+	if RoundingMode(42).String() != "unknown" {
+		t.Errorf("Expected unknown got %s", RoundingMode(42))
+	}
+}
@@ -0,0 +1,104 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrRoundingMismatch happens when a rounding function disagrees with the reference rounding vectors.
+var ErrRoundingMismatch = newError("money.rounding_mismatch", "rounding differs from reference vectors")
+
+// RoundingVector is a reference case for rounding: Amount rounded to Places decimal places with Mode gives Expected.
+// Source tells where the case comes from.
+type RoundingVector struct {
+	Source   string
+	Amount   string
+	Places   int
+	Mode     RoundingMode
+	Expected string
+}
+
+const (
+	sourceIEEE754 = "IEEE 754-2008 §4.3, rounding-direction attributes"
+	sourceGDA     = "General Decimal Arithmetic, round-half-down"
+	sourceBankers = "banker's rounding to cents"
+	sourceEU      = "Council Regulation (EC) No 1103/97, Article 5: euro conversions to the nearest cent, halves up"
+	sourceHMRC    = "HMRC VAT Notice 700, 17.5: invoice VAT rounded down to a whole penny"
+	sourceHMRCLn  = "HMRC VAT Notice 700, 17.6: line VAT rounded down to the nearest 0.1p"
+)
+
+// roundingVectors are the reference cases returned by RoundingVectors. Only ever append.
+var roundingVectors = []RoundingVector{
+	{sourceIEEE754, "11.5", 0, RoundHalfEven, "12"},
+	{sourceIEEE754, "12.5", 0, RoundHalfEven, "12"},
+	{sourceIEEE754, "-11.5", 0, RoundHalfEven, "-12"},
+	{sourceIEEE754, "-12.5", 0, RoundHalfEven, "-12"},
+	{sourceIEEE754, "11.5", 0, RoundHalfUp, "12"},
+	{sourceIEEE754, "12.5", 0, RoundHalfUp, "13"},
+	{sourceIEEE754, "-11.5", 0, RoundHalfUp, "-12"},
+	{sourceIEEE754, "-12.5", 0, RoundHalfUp, "-13"},
+	{sourceIEEE754, "11.5", 0, RoundTruncate, "11"},
+	{sourceIEEE754, "12.5", 0, RoundTruncate, "12"},
+	{sourceIEEE754, "-11.5", 0, RoundTruncate, "-11"},
+	{sourceIEEE754, "-12.5", 0, RoundTruncate, "-12"},
+	{sourceIEEE754, "11.5", 0, RoundCeiling, "12"},
+	{sourceIEEE754, "12.5", 0, RoundCeiling, "13"},
+	{sourceIEEE754, "-11.5", 0, RoundCeiling, "-11"},
+	{sourceIEEE754, "-12.5", 0, RoundCeiling, "-12"},
+	{sourceIEEE754, "11.5", 0, RoundFloor, "11"},
+	{sourceIEEE754, "12.5", 0, RoundFloor, "12"},
+	{sourceIEEE754, "-11.5", 0, RoundFloor, "-12"},
+	{sourceIEEE754, "-12.5", 0, RoundFloor, "-13"},
+
+	{sourceGDA, "11.5", 0, RoundHalfDown, "11"},
+	{sourceGDA, "12.5", 0, RoundHalfDown, "12"},
+	{sourceGDA, "-12.5", 0, RoundHalfDown, "-12"},
+	{sourceGDA, "12.51", 0, RoundHalfDown, "13"},
+	{sourceGDA, "-12.51", 0, RoundHalfDown, "-13"},
+
+	{sourceBankers, "0.125", 2, RoundHalfEven, "0.12"},
+	{sourceBankers, "0.135", 2, RoundHalfEven, "0.14"},
+	{sourceBankers, "-0.125", 2, RoundHalfEven, "-0.12"},
+	{sourceBankers, "2.675", 2, RoundHalfEven, "2.68"},
+	{sourceBankers, "0.1251", 2, RoundHalfEven, "0.13"},
+	{sourceBankers, "1.005", 2, RoundHalfEven, "1"},
+
+	{sourceEU, "51.1291881196", 2, RoundHalfUp, "51.13"},
+	{sourceEU, "1.954", 2, RoundHalfUp, "1.95"},
+	{sourceEU, "1.955", 2, RoundHalfUp, "1.96"},
+	{sourceEU, "0.005", 2, RoundHalfUp, "0.01"},
+
+	{sourceHMRC, "2.198", 2, RoundTruncate, "2.19"},
+	{sourceHMRC, "0.52325", 2, RoundTruncate, "0.52"},
+	{sourceHMRCLn, "2.1987", 3, RoundTruncate, "2.198"},
+	{sourceHMRCLn, "0.52325", 3, RoundTruncate, "0.523"},
+}
+
+// RoundingVectors returns reference cases for every rounding mode, taken from IEEE 754-2008, the General Decimal
+// Arithmetic specification, the EU euro conversion rules and HMRC VAT guidance. Run them with VerifyRounding,
+// or directly, against code that wraps the rounding of the package. The returned slice is a copy.
+func RoundingVectors() []RoundingVector {
+	vs := make([]RoundingVector, len(roundingVectors))
+	copy(vs, roundingVectors)
+	return vs
+}
+
+// VerifyRounding checks a rounding function against the RoundingVectors. It returns an error wrapping
+// ErrRoundingMismatch that lists every vector the function gets wrong, or nil when it gets all of them right.
+func VerifyRounding(round func(amount decimal.Decimal, places int, mode RoundingMode) decimal.Decimal) error {
+	var diffs []string
+	for _, v := range roundingVectors {
+		expected := decimal.RequireFromString(v.Expected)
+		if got := round(decimal.RequireFromString(v.Amount), v.Places, v.Mode); !got.Equal(expected) {
+			diffs = append(diffs, fmt.Sprintf("%s rounded %s to %d places gives %s, want %s (%s)", v.Amount, v.Mode, v.Places, got, v.Expected, v.Source))
+		}
+	}
+
+	if len(diffs) > 0 {
+		return fmt.Errorf("%w:\n%s", ErrRoundingMismatch, strings.Join(diffs, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package money
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// roundMoney rounds an amount of major units through Money in a currency with the given fraction.
+func roundMoney(amount decimal.Decimal, places int, mode RoundingMode) decimal.Decimal {
+	r := NewRegistry()
+	c, err := r.AddCurrency("XRV", "", "1", ".", "", places)
+	if err != nil {
+		panic(err)
+	}
+
+	return r.NewFromDecimal(amount, c.Code).RoundToCurrency(mode).Decimal()
+}
+
+func TestVerifyRounding(t *testing.T) {
+	defer SetBackend(nil)
+
+	for _, backend := range []Backend{DecimalBackend{}, defaultBackend} {
+		SetBackend(backend)
+		if err := VerifyRounding(roundMoney); err != nil {
+			t.Errorf("%T: %v", backend, err)
+		}
+	}
+}
+
+func TestVerifyRounding_Mismatch(t *testing.T) {
+	// decimal.Round rounds half away from zero whatever the mode
+	err := VerifyRounding(func(amount decimal.Decimal, places int, _ RoundingMode) decimal.Decimal {
+		return amount.Round(int32(places))
+	})
+
+	if !errors.Is(err, ErrRoundingMismatch) {
+		t.Fatalf("Expected ErrRoundingMismatch got %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "12.5 rounded half-even to 0 places gives 13, want 12 (IEEE 754-2008") {
+		t.Errorf("Expected the failing vector in the error got %v", err)
+	}
+}
+
+func TestRoundingVectors(t *testing.T) {
+	vs := RoundingVectors()
+
+	modes := map[RoundingMode]bool{}
+	for _, v := range vs {
+		modes[v.Mode] = true
+	}
+
+	for _, mode := range []RoundingMode{RoundHalfUp, RoundHalfDown, RoundHalfEven, RoundFloor, RoundCeiling, RoundTruncate} {
+		if !modes[mode] {
+			t.Errorf("Expected vectors for %s", mode)
+		}
+	}
+
+	vs[0].Expected = "changed"
+	if RoundingVectors()[0].Expected == "changed" {
+		t.Error("Expected RoundingVectors to return a copy")
+	}
+}
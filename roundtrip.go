@@ -0,0 +1,74 @@
+package money
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrRoundTripMismatch happens when displayed Money doesn't parse back to the same Money.
+var ErrRoundTripMismatch = newError("money.round_trip_mismatch", "display doesn't parse back to the same money")
+
+// roundTripAmounts are the amounts VerifyDisplayRoundTrip checks when none are given.
+var roundTripAmounts = []int64{0, 1, -1, 12, -99, 100, 1234, 123456, -123456, 123456789, math.MaxInt64, -math.MaxInt64}
+
+// VerifyDisplayRoundTrip checks the invariant that Display and Parse are inverses for the given currency
+// of the DefaultRegistry, see Registry.VerifyDisplayRoundTrip.
+//
+// Use it in tests to verify custom currencies added with AddCurrency:
+//
+//	if err := money.VerifyDisplayRoundTrip("POINTS"); err != nil {
+//		t.Error(err)
+//	}
+func VerifyDisplayRoundTrip(code string, amounts ...int64) error {
+	return DefaultRegistry.VerifyDisplayRoundTrip(code, amounts...)
+}
+
+// VerifyDisplayRoundTrip checks the invariant that Display and Parse are inverses for the given currency:
+// ParseWithCurrency(m.Display(), code) must return Money equal to m, and so must Parse(m.Display()) whenever
+// the currency is registered and no other registered currency shares its grapheme. It returns an error
+// describing the first violation found, wrapping ErrRoundTripMismatch or the parse error, or nil. When no amounts are given a fixed set of edge cases is checked.
+func (r *Registry) VerifyDisplayRoundTrip(code string, amounts ...int64) error {
+	if len(amounts) == 0 {
+		amounts = roundTripAmounts
+	}
+
+	c := r.get(code)
+	unique := r.GetCurrency(code) != nil && c.Grapheme != ""
+	for _, oc := range r.sorted() {
+		if oc.Grapheme == c.Grapheme && !oc.equals(c) {
+			unique = false
+		}
+	}
+
+	p := &Parser{Registry: r}
+	for _, amount := range amounts {
+		m := &Money{amount: decimal.NewFromInt(amount), currency: c}
+		s := m.Display()
+
+		got, err := p.ParseWithCurrency(s, c.Code)
+		if err != nil {
+			return fmt.Errorf("ParseWithCurrency(%q, %q): %w", s, c.Code, err)
+		}
+
+		if eq, err := got.Equals(m); err != nil || !eq {
+			return fmt.Errorf("%w: ParseWithCurrency(%q, %q) = %d %s, want %d %s", ErrRoundTripMismatch, s, c.Code, got.Amount(), got.currency.Code, amount, c.Code)
+		}
+
+		if !unique {
+			continue
+		}
+
+		got, err = p.Parse(s)
+		if err != nil {
+			return fmt.Errorf("Parse(%q): %w", s, err)
+		}
+
+		if eq, err := got.Equals(m); err != nil || !eq {
+			return fmt.Errorf("%w: Parse(%q) = %d %s, want %d %s", ErrRoundTripMismatch, s, got.Amount(), got.currency.Code, amount, c.Code)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package money
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestVerifyDisplayRoundTrip(t *testing.T) {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		if err := VerifyDisplayRoundTrip(code); err != nil {
+			t.Errorf("%s: %v", code, err)
+		}
+	}
+}
+
+func TestVerifyDisplayRoundTrip_Unregistered(t *testing.T) {
+	if err := VerifyDisplayRoundTrip("NOTACURRENCY"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVerifyDisplayRoundTrip_Violation(t *testing.T) {
+	// A template without the amount placeholder can't be parsed back.
+	r := NewRegistry()
+	if _, err := r.AddCurrency("BROKEN", "B", "$", ".", ",", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.VerifyDisplayRoundTrip("BROKEN", 100); err == nil {
+		t.Error("Expected round-trip violation")
+	}
+}
@@ -0,0 +1,169 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Comparison operators supported by Predicate conditions.
+const (
+	OpEqual              = "eq"
+	OpGreaterThan        = "gt"
+	OpGreaterThanOrEqual = "gte"
+	OpLessThan           = "lt"
+	OpLessThanOrEqual    = "lte"
+)
+
+// Predicate is a reusable, serializable condition over Money, built with Rule:
+//
+//	p := money.Rule().Currency(money.EUR).GreaterThan(min).LessThanOrEqual(max)
+//	p.Match(total) // true when total is in EUR and within (min, max]
+//
+// Conditions are stored as amounts in minor units of the predicate currency, so a Predicate marshals
+// into plain JSON that can be stored alongside other rules, e.g.
+// {"currency":"EUR","conditions":[{"op":"gt","amount":1000},{"op":"lte","amount":50000}]}.
+type Predicate struct {
+	currency   string
+	conditions []Condition
+	err        error
+}
+
+// Condition is a single comparison of a Predicate against an amount in minor units.
+type Condition struct {
+	Op     string `json:"op"`
+	Amount int64  `json:"amount"`
+}
+
+// Rule starts building a new Predicate matching any Money.
+func Rule() *Predicate {
+	return &Predicate{}
+}
+
+// Currency restricts the predicate to Money of the given currency.
+func (p *Predicate) Currency(code string) *Predicate {
+	p.setCurrency(strings.ToUpper(code))
+	return p
+}
+
+// Equals adds a condition matching Money equal to om.
+func (p *Predicate) Equals(om *Money) *Predicate {
+	return p.add(OpEqual, om)
+}
+
+// GreaterThan adds a condition matching Money greater than om.
+func (p *Predicate) GreaterThan(om *Money) *Predicate {
+	return p.add(OpGreaterThan, om)
+}
+
+// GreaterThanOrEqual adds a condition matching Money greater than or equal to om.
+func (p *Predicate) GreaterThanOrEqual(om *Money) *Predicate {
+	return p.add(OpGreaterThanOrEqual, om)
+}
+
+// LessThan adds a condition matching Money less than om.
+func (p *Predicate) LessThan(om *Money) *Predicate {
+	return p.add(OpLessThan, om)
+}
+
+// LessThanOrEqual adds a condition matching Money less than or equal to om.
+func (p *Predicate) LessThanOrEqual(om *Money) *Predicate {
+	return p.add(OpLessThanOrEqual, om)
+}
+
+// Err returns the first error encountered while building the predicate, such as bounds
+// given in a currency other than the predicate currency, or ErrLossyConversion for bounds carrying
+// precision beyond the currency fraction, which conditions can't hold.
+func (p *Predicate) Err() error {
+	return p.err
+}
+
+// Match reports whether m satisfies every condition of the predicate.
+// Money in another currency than the predicate currency never matches, neither does any Money
+// when the predicate failed to build.
+func (p *Predicate) Match(m *Money) bool {
+	if p.err != nil {
+		return false
+	}
+
+	if p.currency != "" && m.currency.Code != p.currency {
+		return false
+	}
+
+	for _, c := range p.conditions {
+		cmp := m.amount.Cmp(decimal.NewFromInt(c.Amount))
+
+		var ok bool
+		switch c.Op {
+		case OpEqual:
+			ok = cmp == 0
+		case OpGreaterThan:
+			ok = cmp > 0
+		case OpGreaterThanOrEqual:
+			ok = cmp >= 0
+		case OpLessThan:
+			ok = cmp < 0
+		case OpLessThanOrEqual:
+			ok = cmp <= 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (p *Predicate) MarshalJSON() ([]byte, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return json.Marshal(predicateJSON{Currency: p.currency, Conditions: p.conditions})
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (p *Predicate) UnmarshalJSON(b []byte) error {
+	var data predicateJSON
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	for _, c := range data.Conditions {
+		switch c.Op {
+		case OpEqual, OpGreaterThan, OpGreaterThanOrEqual, OpLessThan, OpLessThanOrEqual:
+		default:
+			return fmt.Errorf("%w: unknown predicate operator %q", ErrInvalidJSONUnmarshal, c.Op)
+		}
+	}
+
+	*p = Predicate{currency: strings.ToUpper(data.Currency), conditions: data.Conditions}
+	return nil
+}
+
+type predicateJSON struct {
+	Currency   string      `json:"currency,omitempty"`
+	Conditions []Condition `json:"conditions"`
+}
+
+func (p *Predicate) add(op string, om *Money) *Predicate {
+	p.setCurrency(om.currency.Code)
+	if !om.amount.IsInteger() && p.err == nil {
+		p.err = ErrLossyConversion
+	}
+	p.conditions = append(p.conditions, Condition{Op: op, Amount: om.amount.IntPart()})
+	return p
+}
+
+func (p *Predicate) setCurrency(code string) {
+	switch {
+	case p.currency == "":
+		p.currency = code
+	case p.currency != code && p.err == nil:
+		p.err = ErrCurrencyMismatch
+	}
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRule_Match(t *testing.T) {
+	p := Rule().Currency(EUR).GreaterThan(New(1000, EUR)).LessThanOrEqual(New(50000, EUR))
+
+	tcs := []struct {
+		money    *Money
+		expected bool
+	}{
+		{New(1000, EUR), false},
+		{New(1001, EUR), true},
+		{New(50000, EUR), true},
+		{New(50001, EUR), false},
+		{New(2000, USD), false},
+	}
+
+	for _, tc := range tcs {
+		if r := p.Match(tc.money); r != tc.expected {
+			t.Errorf("Expected match of %s to be %t got %t", tc.money.Display(), tc.expected, r)
+		}
+	}
+}
+
+func TestRule_Operators(t *testing.T) {
+	m := New(100, USD)
+	tcs := []struct {
+		predicate *Predicate
+		expected  bool
+	}{
+		{Rule(), true},
+		{Rule().Equals(New(100, USD)), true},
+		{Rule().Equals(New(99, USD)), false},
+		{Rule().GreaterThanOrEqual(New(100, USD)), true},
+		{Rule().GreaterThan(New(100, USD)), false},
+		{Rule().LessThan(New(101, USD)), true},
+		{Rule().LessThan(New(100, USD)), false},
+		{Rule().Currency("usd"), true},
+	}
+
+	for i, tc := range tcs {
+		if r := tc.predicate.Match(m); r != tc.expected {
+			t.Errorf("Expected predicate %d to return %t got %t", i, tc.expected, r)
+		}
+	}
+}
+
+func TestRule_CurrencyMismatch(t *testing.T) {
+	p := Rule().Currency(EUR).GreaterThan(New(100, USD))
+
+	if !errors.Is(p.Err(), ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", p.Err())
+	}
+
+	if p.Match(New(1000, EUR)) || p.Match(New(1000, USD)) {
+		t.Error("Expected a predicate that failed to build not to match")
+	}
+
+	if _, err := json.Marshal(p); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+}
+
+func TestRule_LossyBound(t *testing.T) {
+	p := Rule().GreaterThan(NewFromDecimal(decimal.RequireFromString("3.199"), USD))
+	if !errors.Is(p.Err(), ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, p.Err())
+	}
+	if p.Match(New(320, USD)) {
+		t.Error("Expected a predicate that failed to build not to match")
+	}
+}
+
+func TestRule_JSON(t *testing.T) {
+	p := Rule().Currency(EUR).GreaterThan(New(1000, EUR)).LessThanOrEqual(New(50000, EUR))
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"currency":"EUR","conditions":[{"op":"gt","amount":1000},{"op":"lte","amount":50000}]}`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	var r Predicate
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	if !r.Match(New(2000, EUR)) || r.Match(New(100, EUR)) || r.Match(New(2000, USD)) {
+		t.Errorf("Unexpected matches for unmarshalled predicate %+v", r)
+	}
+
+	err = json.Unmarshal([]byte(`{"conditions":[{"op":"between","amount":1}]}`), &r)
+	if err == nil {
+		t.Error("Expected error for unknown operator")
+	}
+}
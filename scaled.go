@@ -0,0 +1,106 @@
+package money
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrLossyConversion happens when converting a value would silently drop digits.
+	ErrLossyConversion = newError("money.lossy_conversion", "conversion would lose precision")
+
+	// ErrOverflow happens when a value doesn't fit the integer type it is converted to.
+	ErrOverflow = newError("money.overflow", "amount overflows")
+)
+
+// ScaledAmount returns Money as an integer-only triple suitable for cross-language transport:
+// the monetary value equals value × 10^-scale major units of currency code, which maps directly onto
+// Java's BigDecimal(unscaledValue, scale) and Python's Decimal((sign, digits, -scale)).
+// The scale is always the currency fraction, so value is the amount in minor units. To keep the exchange
+// loss-free, it returns ErrLossyConversion when Money carries precision beyond the currency fraction, see
+// Unscaled for those amounts, and ErrOverflow when the amount doesn't fit an int64.
+func (m *Money) ScaledAmount() (value int64, scale int32, code string, err error) {
+	if !m.amount.IsInteger() {
+		return 0, 0, "", ErrLossyConversion
+	}
+
+	if !m.amount.BigInt().IsInt64() {
+		return 0, 0, "", ErrOverflow
+	}
+
+	return m.amount.IntPart(), int32(m.currency.Fraction), m.currency.Code, nil
+}
+
+// NewFromScaled creates and returns new instance of Money from value × 10^-scale major units,
+// the inverse of ScaledAmount. Values with a different scale than the currency fraction are rescaled;
+// ErrLossyConversion is returned when that would drop non-zero digits and ErrOverflow when the
+// result doesn't fit an int64 amount of minor units.
+func NewFromScaled(value int64, scale int32, code string) (*Money, error) {
+	c := newCurrency(code).get()
+	if value == 0 {
+		return &Money{amount: decimal.Zero, currency: c}, nil
+	}
+
+	// A non-zero int64 has at most 19 digits, so rescaling by more than 18 digits always overflows or drops some.
+	shift := int64(c.Fraction) - int64(scale)
+	switch {
+	case shift > 18:
+		return nil, ErrOverflow
+	case shift < -18:
+		return nil, ErrLossyConversion
+	}
+
+	d := decimal.New(value, int32(shift))
+	if !d.IsInteger() {
+		return nil, ErrLossyConversion
+	}
+
+	amount := d.BigInt()
+	if !amount.IsInt64() {
+		return nil, ErrOverflow
+	}
+
+	return &Money{amount: decimal.NewFromInt(amount.Int64()), currency: c}, nil
+}
+
+// Unscaled returns Money in the (unscaledValue, scale) form of Java's BigDecimal and C#'s SqlDecimal:
+// the monetary value equals value × 10^-scale major units. Unlike ScaledAmount it is exact for any amount;
+// the scale is the currency fraction, or more when Money carries precision beyond it.
+func (m *Money) Unscaled() (value *big.Int, scale int32) {
+	var extra int32
+	for !m.amount.Shift(extra).IsInteger() {
+		extra++
+	}
+
+	return m.amount.Shift(extra).BigInt(), int32(m.currency.Fraction) + extra
+}
+
+// NewFromUnscaled creates and returns new instance of Money from value × 10^-scale major units,
+// the inverse of Unscaled and the semantics of Java's BigDecimal(unscaledVal, scale).
+// Like NewFromDecimal, the amount is kept exactly, including any precision beyond the currency fraction.
+func NewFromUnscaled(value *big.Int, scale int32, code string) *Money {
+	return NewFromDecimal(decimal.NewFromBigInt(value, -scale), code)
+}
+
+// ToInt32Minor returns the amount in minor units as an int32, for legacy systems with narrower integers.
+// It returns ErrOverflow when the amount doesn't fit an int32 and ErrLossyConversion when Money carries
+// precision beyond the currency fraction, instead of silently truncating.
+func (m *Money) ToInt32Minor() (int32, error) {
+	if !m.amount.IsInteger() {
+		return 0, ErrLossyConversion
+	}
+
+	if m.amount.GreaterThan(decimal.NewFromInt(math.MaxInt32)) || m.amount.LessThan(decimal.NewFromInt(math.MinInt32)) {
+		return 0, ErrOverflow
+	}
+
+	return int32(m.amount.IntPart()), nil
+}
+
+// ToFloat64Major returns the amount in major units as the nearest float64, and whether it represents
+// the amount exactly. Most decimal amounts, such as 0.10, have no exact float64 representation.
+func (m *Money) ToFloat64Major() (f float64, exact bool) {
+	return m.Decimal().Float64()
+}
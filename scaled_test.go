@@ -0,0 +1,190 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_ScaledAmount(t *testing.T) {
+	tcs := []struct {
+		amount int64
+		code   string
+		value  int64
+		scale  int32
+	}{
+		{12345, USD, 12345, 2},
+		{-1, EUR, -1, 2},
+		{500, JPY, 500, 0},
+		{1234, BHD, 1234, 3},
+	}
+
+	for _, tc := range tcs {
+		value, scale, code, err := New(tc.amount, tc.code).ScaledAmount()
+
+		if err != nil || value != tc.value || scale != tc.scale || code != tc.code {
+			t.Errorf("Expected (%d, %d, %s) got (%d, %d, %s, %v)", tc.value, tc.scale, tc.code, value, scale, code, err)
+		}
+	}
+
+	if _, _, _, err := NewFromDecimal(decimal.RequireFromString("3.199"), USD).ScaledAmount(); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, err)
+	}
+
+	if _, _, _, err := New(math.MaxInt64, USD).Multiply(10).ScaledAmount(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected %v got %v", ErrOverflow, err)
+	}
+}
+
+func TestNewFromScaled(t *testing.T) {
+	tcs := []struct {
+		value    int64
+		scale    int32
+		code     string
+		expected int64
+		err      error
+	}{
+		{12345, 2, USD, 12345, nil},
+		{123450, 3, USD, 12345, nil},
+		{-1234500, 4, USD, -12345, nil},
+		{12345, 3, USD, 0, ErrLossyConversion},
+		{123, 0, USD, 12300, nil},
+		{-5, -2, JPY, -500, nil},
+		{math.MaxInt64, 0, USD, 0, ErrOverflow},
+		{math.MinInt64 / 10, 1, BHD, 0, ErrOverflow},
+		{math.MinInt64 / 100, 1, BHD, math.MinInt64 / 100 * 100, nil},
+		{0, -2e9, USD, 0, nil},
+		{0, math.MinInt32, USD, 0, nil},
+		{1, -2e9, USD, 0, ErrOverflow},
+		{1, math.MinInt32, USD, 0, ErrOverflow},
+		{1, math.MaxInt32, USD, 0, ErrLossyConversion},
+		{9e18, 20, USD, 9, nil},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromScaled(tc.value, tc.scale, tc.code)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected error %v for (%d, %d, %s) got %v", tc.err, tc.value, tc.scale, tc.code, err)
+			continue
+		}
+
+		if err == nil && (m.Amount() != tc.expected || m.Currency().Code != tc.code) {
+			t.Errorf("Expected %d %s got %d %s", tc.expected, tc.code, m.Amount(), m.Currency().Code)
+		}
+	}
+}
+
+func TestScaledAmount_RoundTrip(t *testing.T) {
+	for _, amount := range []int64{0, 1, -1, 99, 123456789, math.MaxInt64, math.MinInt64} {
+		m := New(amount, EUR)
+		value, scale, code, err := m.ScaledAmount()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := NewFromScaled(value, scale, code)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if eq, _ := r.Equals(m); !eq {
+			t.Errorf("Expected %d to round-trip got %d", amount, r.Amount())
+		}
+	}
+}
+
+func TestMoney_ToInt32Minor(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		expected int32
+		err      error
+	}{
+		{New(123456, EUR), 123456, nil},
+		{New(math.MaxInt32, EUR), math.MaxInt32, nil},
+		{New(math.MinInt32, EUR), math.MinInt32, nil},
+		{New(math.MaxInt32+1, EUR), 0, ErrOverflow},
+		{New(math.MinInt32-1, EUR), 0, ErrOverflow},
+		{NewFromDecimal(decimal.RequireFromString("0.015"), EUR), 0, ErrLossyConversion},
+	}
+
+	for _, tc := range tcs {
+		v, err := tc.money.ToInt32Minor()
+		if !errors.Is(err, tc.err) || v != tc.expected {
+			t.Errorf("Expected %d, %v got %d, %v", tc.expected, tc.err, v, err)
+		}
+	}
+}
+
+func TestMoney_ToFloat64Major(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		expected float64
+		exact    bool
+	}{
+		{New(150, EUR), 1.5, true},
+		{New(-25, EUR), -0.25, true},
+		{New(10, EUR), 0.1, false},
+		{New(1234, JPY), 1234, true},
+		{New(math.MaxInt64, JPY), math.MaxInt64, false},
+	}
+
+	for _, tc := range tcs {
+		f, exact := tc.money.ToFloat64Major()
+		if f != tc.expected || exact != tc.exact {
+			t.Errorf("Expected %v, %v got %v, %v", tc.expected, tc.exact, f, exact)
+		}
+	}
+}
+
+func TestMoney_Unscaled(t *testing.T) {
+	tcs := []struct {
+		money *Money
+		value string
+		scale int32
+	}{
+		{New(12345, EUR), "12345", 2},
+		{New(-1, USD), "-1", 2},
+		{New(0, EUR), "0", 2},
+		{New(1234, JPY), "1234", 0},
+		{New(1234, BHD), "1234", 3},
+		{NewFromDecimal(decimal.RequireFromString("3.199"), USD), "3199", 3},
+		{NewFromDecimal(decimal.RequireFromString("3.1990"), USD), "3199", 3},
+		{NewFromDecimal(decimal.RequireFromString("1e20"), USD), "10000000000000000000000", 2},
+	}
+
+	for _, tc := range tcs {
+		value, scale := tc.money.Unscaled()
+		if value.String() != tc.value || scale != tc.scale {
+			t.Errorf("Expected (%s, %d) got (%s, %d)", tc.value, tc.scale, value, scale)
+		}
+
+		m := NewFromUnscaled(value, scale, tc.money.Currency().Code)
+		if !m.amount.Equal(tc.money.amount) {
+			t.Errorf("Expected %s got %s", tc.money.amount, m.amount)
+		}
+	}
+}
+
+func TestNewFromUnscaled(t *testing.T) {
+	tcs := []struct {
+		value    int64
+		scale    int32
+		code     string
+		expected string
+	}{
+		{12345, 2, EUR, "123.45"},
+		{12345, 4, EUR, "1.2345"},
+		{12, -2, JPY, "1200"},
+		{-5, 0, USD, "-5"},
+	}
+
+	for _, tc := range tcs {
+		m := NewFromUnscaled(big.NewInt(tc.value), tc.scale, tc.code)
+		if !m.Decimal().Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s got %s", tc.expected, m.Decimal())
+		}
+	}
+}
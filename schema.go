@@ -0,0 +1,38 @@
+package money
+
+import (
+	"encoding/json"
+)
+
+// SchemaOffer is a schema.org Offer fragment carrying the price of a product.
+type SchemaOffer struct {
+	Type          string `json:"@type"`
+	Price         string `json:"price"`
+	PriceCurrency string `json:"priceCurrency"`
+}
+
+// SchemaPrice returns the amount in the canonical form required by schema.org structured data:
+// major units with a dot decimal separator, no grouping and no symbol, e.g. "1234.56", which is MachineString.
+func (m *Money) SchemaPrice() string {
+	return m.MachineString()
+}
+
+// SchemaPriceCurrency returns the ISO 4217 code to be used as schema.org priceCurrency.
+func (m *Money) SchemaPriceCurrency() string {
+	return m.currency.Code
+}
+
+// SchemaOffer returns the schema.org Offer fragment for Money.
+func (m *Money) SchemaOffer() SchemaOffer {
+	return SchemaOffer{
+		Type:          "Offer",
+		Price:         m.SchemaPrice(),
+		PriceCurrency: m.SchemaPriceCurrency(),
+	}
+}
+
+// JSONLDOffer returns the JSON-LD encoding of the schema.org Offer fragment for Money,
+// e.g. {"@type":"Offer","price":"1234.56","priceCurrency":"EUR"}.
+func (m *Money) JSONLDOffer() ([]byte, error) {
+	return json.Marshal(m.SchemaOffer())
+}
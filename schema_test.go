@@ -0,0 +1,45 @@
+package money
+
+import (
+	"testing"
+)
+
+func TestMoney_SchemaPrice(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		code     string
+		expected string
+	}{
+		{123456, EUR, "1234.56"},
+		{123456, BRL, "1234.56"},
+		{-1, USD, "-0.01"},
+		{0, USD, "0.00"},
+		{1234, JPY, "1234"},
+		{1234, BHD, "1.234"},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		r := m.SchemaPrice()
+
+		if r != tc.expected {
+			t.Errorf("Expected schema price of %d %s to be %s got %s", tc.amount, tc.code, tc.expected, r)
+		}
+
+		if m.SchemaPriceCurrency() != tc.code {
+			t.Errorf("Expected schema price currency %s got %s", tc.code, m.SchemaPriceCurrency())
+		}
+	}
+}
+
+func TestMoney_JSONLDOffer(t *testing.T) {
+	b, err := New(123456, EUR).JSONLDOffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"@type":"Offer","price":"1234.56","priceCurrency":"EUR"}`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+}
@@ -0,0 +1,24 @@
+package money
+
+import "fmt"
+
+// StrictWholeUnits makes the operations that would silently round a fraction of a unit of a currency without
+// minor units, such as JPY or KRW, fail with ErrFractionalAmount instead: Divide, DivideDecimal without a rounding
+// mode, Split and Allocate. The caller then has to decide on the rounding explicitly, e.g. with DivideWithMode,
+// DivideDecimal with a mode, AllocateDecimal or Installments. Currencies with minor units are not affected.
+// It is off by default; set it once at start-up.
+var StrictWholeUnits = false
+
+// ErrFractionalAmount happens when StrictWholeUnits is on and an operation would produce a fraction of a unit
+// of a currency without minor units.
+var ErrFractionalAmount = newError("money.fractional_amount", "fraction of a whole-unit currency")
+
+// strictWholeUnits reports whether the operations on Money in the currency must not round fractions implicitly.
+func strictWholeUnits(c *Currency) bool {
+	return StrictWholeUnits && c != nil && c.Fraction == 0
+}
+
+// fractionalError returns an error wrapping ErrFractionalAmount for the operation on Money.
+func fractionalError(m *Money, op string) error {
+	return fmt.Errorf("%w: %s of %s %s", ErrFractionalAmount, op, m.amount, m.currency.Code)
+}
@@ -0,0 +1,34 @@
+//go:build go1.23
+// +build go1.23
+
+package money
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestStrictWholeUnits_Seq(t *testing.T) {
+	StrictWholeUnits = true
+	defer func() { StrictWholeUnits = false }()
+
+	if _, err := New(1000, JPY).SplitSeq(3); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+	if _, err := New(100, JPY).AllocateSeq(33, 33, 33); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+
+	seq, err := New(1000, JPY).AllocateSeq(1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms := slices.Collect(seq); ms[0].Amount() != 250 || ms[1].Amount() != 750 {
+		t.Errorf("Expected [250 750] got %v", ms)
+	}
+
+	if _, err := New(1000, EUR).SplitSeq(3); err != nil {
+		t.Errorf("Expected currencies with minor units to be unaffected got %v", err)
+	}
+}
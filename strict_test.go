@@ -0,0 +1,51 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestStrictWholeUnits(t *testing.T) {
+	StrictWholeUnits = true
+	defer func() { StrictWholeUnits = false }()
+
+	yen := New(1000, JPY)
+
+	if _, err := yen.Divide(3); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+	if _, err := yen.DivideDecimal(decimal.NewFromInt(3)); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+	if _, err := yen.Split(3); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+	if _, err := yen.Allocate(33, 33, 33); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+	if _, err := New(999, KRW).Allocate(50, 50); !errors.Is(err, ErrFractionalAmount) {
+		t.Errorf("Expected ErrFractionalAmount got %v", err)
+	}
+
+	if r, err := yen.Divide(4); err != nil || r.Amount() != 250 {
+		t.Errorf("Expected %d got %v, %v", 250, r, err)
+	}
+	if r, err := yen.DivideWithMode(RoundFloor, 3); err != nil || r.Amount() != 333 {
+		t.Errorf("Expected %d got %v, %v", 333, r, err)
+	}
+	if ms, err := yen.Allocate(1, 3); err != nil || ms[0].Amount() != 250 || ms[1].Amount() != 750 {
+		t.Errorf("Expected [250 750] got %v, %v", ms, err)
+	}
+
+	if ms, err := New(1000, EUR).Split(3); err != nil || ms[0].Amount() != 334 {
+		t.Errorf("Expected currencies with minor units to be unaffected got %v, %v", ms, err)
+	}
+}
+
+func TestStrictWholeUnits_Off(t *testing.T) {
+	if ms, err := New(1000, JPY).Split(3); err != nil || ms[0].Amount() != 334 {
+		t.Errorf("Expected %d got %v, %v", 334, ms, err)
+	}
+}
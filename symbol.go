@@ -0,0 +1,128 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// AmbiguousCurrencyError happens when a symbol matches several currencies and nothing tells them apart,
+// such as "$" in a document without locale. It wraps ErrAmbiguousCurrency.
+type AmbiguousCurrencyError struct {
+	Symbol     string
+	Candidates []*Currency
+}
+
+// Error implements error.
+func (e *AmbiguousCurrencyError) Error() string {
+	codes := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		codes[i] = c.Code
+	}
+
+	return fmt.Sprintf("%v: %q matches %s", ErrAmbiguousCurrency, e.Symbol, strings.Join(codes, ", "))
+}
+
+// Unwrap returns ErrAmbiguousCurrency, so that errors.Is(err, ErrAmbiguousCurrency) holds for ambiguity errors.
+func (e *AmbiguousCurrencyError) Unwrap() error {
+	return ErrAmbiguousCurrency
+}
+
+// CurrenciesBySymbol returns the currencies of the DefaultRegistry displayed with the given symbol, ordered by code,
+// e.g. AUD, CAD, USD and others for "$". It returns nil when no currency uses the symbol.
+func CurrenciesBySymbol(symbol string) []*Currency {
+	return DefaultRegistry.CurrenciesBySymbol(symbol)
+}
+
+// GuessCurrency returns the currency of the DefaultRegistry most likely meant by a symbol in the given locale,
+// such as CAD for "$" in "en-CA". See Registry.GuessCurrency.
+func GuessCurrency(symbol, locale string) (*Currency, error) {
+	return DefaultRegistry.GuessCurrency(symbol, locale)
+}
+
+// CurrenciesBySymbol returns the currencies of the registry displayed with the given symbol, ordered by code.
+// Symbols are compared after folding lookalike characters, so a fullwidth "＄" matches "$".
+func (r *Registry) CurrenciesBySymbol(symbol string) []*Currency {
+	symbol = fold(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return nil
+	}
+
+	var cs []*Currency
+	for _, c := range r.sorted() {
+		if fold(c.Grapheme) == symbol {
+			cs = append(cs, c)
+		}
+	}
+
+	return cs
+}
+
+// GuessCurrency returns the currency of the registry most likely meant by a symbol. A symbol used by a single
+// currency maps to it. A shared symbol maps to the currency of the locale region when it uses the symbol, e.g. "kr"
+// is SEK in "sv-SE" and NOK in "nb-NO", then to its most common currency, e.g. USD for "$" and GBP for "£".
+// The locale is a BCP 47 tag and may be empty. It returns ErrUnknownCurrency when no currency uses the symbol and
+// an *AmbiguousCurrencyError listing the candidates when it can't pick one.
+func (r *Registry) GuessCurrency(symbol, locale string) (*Currency, error) {
+	cs := r.CurrenciesBySymbol(symbol)
+	switch len(cs) {
+	case 0:
+		return nil, fmt.Errorf("%w: symbol %q", ErrUnknownCurrency, symbol)
+	case 1:
+		return cs[0], nil
+	}
+
+	if c := pickCurrency(fold(strings.TrimSpace(symbol)), locale, cs); c != nil {
+		return c, nil
+	}
+
+	return nil, &AmbiguousCurrencyError{Symbol: symbol, Candidates: cs}
+}
+
+// pickCurrency returns the currency of the locale region among the candidates sharing a symbol, else the
+// preferred currency for the symbol, or nil if neither is a candidate.
+func pickCurrency(symbol, locale string, cs []*Currency) *Currency {
+	if code, ok := regionCurrency(locale); ok {
+		for _, c := range cs {
+			if c.Code == code {
+				return c
+			}
+		}
+	}
+
+	if code, ok := preferredCurrencies[symbol]; ok {
+		for _, c := range cs {
+			if c.Code == code {
+				return c
+			}
+		}
+	}
+
+	return nil
+}
+
+// regionCurrency returns the code of the currency used in the region of a BCP 47 locale, such as CAD for "fr-CA".
+func regionCurrency(locale string) (string, bool) {
+	if locale == "" {
+		return "", false
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return "", false
+	}
+
+	region, confidence := tag.Region()
+	if confidence != language.Exact {
+		return "", false
+	}
+
+	unit, ok := currency.FromRegion(region)
+	if !ok {
+		return "", false
+	}
+
+	return unit.String(), true
+}
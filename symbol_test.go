@@ -0,0 +1,108 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCurrenciesBySymbol(t *testing.T) {
+	cs := CurrenciesBySymbol("$")
+	if len(cs) < 2 {
+		t.Fatalf("Expected several currencies for $ got %d", len(cs))
+	}
+
+	for i, c := range cs {
+		if c.Grapheme != "$" {
+			t.Errorf("Expected grapheme $ got %s for %s", c.Grapheme, c.Code)
+		}
+		if i > 0 && cs[i-1].Code >= c.Code {
+			t.Errorf("Expected currencies ordered by code got %s before %s", cs[i-1].Code, c.Code)
+		}
+	}
+
+	if cs := CurrenciesBySymbol("＄"); len(cs) != len(CurrenciesBySymbol("$")) {
+		t.Errorf("Expected fullwidth dollar sign to match $ got %d currencies", len(cs))
+	}
+
+	if cs := CurrenciesBySymbol("€"); len(cs) != 1 || cs[0].Code != EUR {
+		t.Errorf("Expected [EUR] got %v", cs)
+	}
+
+	for _, symbol := range []string{"", "  ", "no such symbol"} {
+		if cs := CurrenciesBySymbol(symbol); cs != nil {
+			t.Errorf("Expected no currency for %q got %d", symbol, len(cs))
+		}
+	}
+}
+
+func TestGuessCurrency(t *testing.T) {
+	tcs := []struct {
+		symbol string
+		locale string
+		code   string
+	}{
+		{"€", "", EUR},
+		{"$", "", USD},
+		{"$", "en-CA", CAD},
+		{"$", "fr-CA", CAD},
+		{"$", "es-MX", MXN},
+		{"$", "de-DE", USD},
+		{"$", "not a locale", USD},
+		{"kr", "sv-SE", SEK},
+		{"kr", "nb-NO", NOK},
+	}
+
+	for _, tc := range tcs {
+		c, err := GuessCurrency(tc.symbol, tc.locale)
+		if err != nil {
+			t.Errorf("Unexpected error guessing %q in %q: %v", tc.symbol, tc.locale, err)
+			continue
+		}
+
+		if c.Code != tc.code {
+			t.Errorf("Expected %q in %q to be %s got %s", tc.symbol, tc.locale, tc.code, c.Code)
+		}
+	}
+}
+
+func TestGuessCurrency_Errors(t *testing.T) {
+	if _, err := GuessCurrency("no such symbol", ""); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected ErrUnknownCurrency got %v", err)
+	}
+
+	_, err := GuessCurrency("kr", "en-US")
+	if !errors.Is(err, ErrAmbiguousCurrency) {
+		t.Fatalf("Expected ErrAmbiguousCurrency got %v", err)
+	}
+
+	var ambiguous *AmbiguousCurrencyError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("Expected *AmbiguousCurrencyError got %T", err)
+	}
+	if ambiguous.Symbol != "kr" || len(ambiguous.Candidates) != len(CurrenciesBySymbol("kr")) {
+		t.Errorf("Expected kr and its %d currencies got %q and %d", len(CurrenciesBySymbol("kr")), ambiguous.Symbol, len(ambiguous.Candidates))
+	}
+}
+
+func TestParser_Locale(t *testing.T) {
+	m, err := (&Parser{Locale: "en-CA"}).Parse("$1.00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Amount() != 100 || m.Currency().Code != CAD {
+		t.Errorf("Expected 100 CAD got %d %s", m.Amount(), m.Currency().Code)
+	}
+
+	m, err = (&Parser{Locale: "es-AR"}).Parse("$1.234")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Amount() != 123400 || m.Currency().Code != ARS {
+		t.Errorf("Expected 123400 ARS got %d %s", m.Amount(), m.Currency().Code)
+	}
+
+	var ambiguous *AmbiguousCurrencyError
+	if _, err := Parse("$1.234"); !errors.As(err, &ambiguous) || ambiguous.Symbol != "$" {
+		t.Errorf("Expected *AmbiguousCurrencyError for $ got %v", err)
+	}
+}
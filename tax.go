@@ -0,0 +1,48 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// ErrInvalidTaxRate happens when a tax rate is negative.
+var ErrInvalidTaxRate = newError("money.invalid_tax_rate", "tax rate must not be negative")
+
+// hundred is the divisor turning percentages into fractions.
+var hundred = decimal.NewFromInt(100)
+
+// Percent returns new Money struct with value representing p percent of Self, rounded to whole minor units
+// using the given rounding mode, half away from zero by default. The percentage is converted to a decimal
+// first, so Percent(19) is exactly 19% rather than a float approximation of it.
+func (m *Money) Percent(p float64, mode ...RoundingMode) *Money {
+	return m.PercentDecimal(decimal.NewFromFloat(p), mode...)
+}
+
+// PercentDecimal returns new Money struct with value representing d percent of Self, rounded to whole minor units
+// using the given rounding mode, half away from zero by default.
+func (m *Money) PercentDecimal(d decimal.Decimal, mode ...RoundingMode) *Money {
+	amount := mutate.calc.multiplyDecimal(m.amount, d)
+	return &Money{amount: mutate.calc.divideRound(amount, hundred, roundingMode(mode)), currency: m.currency}
+}
+
+// AddTax treats Self as a net amount and adds tax at the given rate in percent, e.g. 19 for 19% VAT.
+// The tax is rounded to whole minor units using the given rounding mode, half away from zero by default,
+// and gross is always exactly Self plus tax.
+func (m *Money) AddTax(rate decimal.Decimal, mode ...RoundingMode) (gross, tax *Money, err error) {
+	if rate.IsNegative() {
+		return nil, nil, ErrInvalidTaxRate
+	}
+
+	tax = m.PercentDecimal(rate, mode...)
+	return &Money{amount: mutate.calc.add(m.amount, tax.amount), currency: m.currency}, tax, nil
+}
+
+// ExtractTax treats Self as a gross amount including tax at the given rate in percent, e.g. 19 for 19% VAT,
+// and splits it into its net and tax parts. The tax is rounded to whole minor units using the given rounding mode,
+// half away from zero by default, and the net amount takes the remainder, so net plus tax is always exactly Self.
+func (m *Money) ExtractTax(rate decimal.Decimal, mode ...RoundingMode) (net, tax *Money, err error) {
+	if rate.IsNegative() {
+		return nil, nil, ErrInvalidTaxRate
+	}
+
+	amount := mutate.calc.multiplyDecimal(m.amount, rate)
+	tax = &Money{amount: mutate.calc.divideRound(amount, hundred.Add(rate), roundingMode(mode)), currency: m.currency}
+	return &Money{amount: mutate.calc.subtract(m.amount, tax.amount), currency: m.currency}, tax, nil
+}
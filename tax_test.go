@@ -0,0 +1,104 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_Percent(t *testing.T) {
+	tcs := []struct {
+		amount   int64
+		percent  float64
+		mode     []RoundingMode
+		expected int64
+	}{
+		{10000, 19, nil, 1900},
+		{999, 19, nil, 190},
+		{999, 19, []RoundingMode{RoundFloor}, 189},
+		{-999, 19, nil, -190},
+		{1000, 0.1, nil, 1},
+		{1000, 7.5, nil, 75},
+		{1, 50, []RoundingMode{RoundHalfEven}, 0},
+		{100, 250, nil, 250},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR).Percent(tc.percent, tc.mode...)
+		if m.Amount() != tc.expected {
+			t.Errorf("Expected %v%% of %d to be %d got %d", tc.percent, tc.amount, tc.expected, m.Amount())
+		}
+	}
+}
+
+func TestMoney_AddTax(t *testing.T) {
+	gross, tax, err := New(999, EUR).AddTax(decimal.NewFromInt(19))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if gross.Amount() != 1189 || tax.Amount() != 190 {
+		t.Errorf("Expected gross %d and tax %d got %d and %d", 1189, 190, gross.Amount(), tax.Amount())
+	}
+}
+
+func TestMoney_ExtractTax(t *testing.T) {
+	net, tax, err := New(1189, EUR).ExtractTax(decimal.NewFromInt(19))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if net.Amount() != 999 || tax.Amount() != 190 {
+		t.Errorf("Expected net %d and tax %d got %d and %d", 999, 190, net.Amount(), tax.Amount())
+	}
+
+	net, tax, err = New(1000, EUR).ExtractTax(decimal.Zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if net.Amount() != 1000 || tax.Amount() != 0 {
+		t.Errorf("Expected net %d and tax %d got %d and %d", 1000, 0, net.Amount(), tax.Amount())
+	}
+}
+
+func TestMoney_TaxSumsToGross(t *testing.T) {
+	rates := []string{"0", "5", "7", "7.7", "19", "20", "21", "25.5"}
+	modes := []RoundingMode{RoundHalfUp, RoundHalfEven, RoundFloor, RoundCeiling, RoundTruncate}
+
+	for _, rate := range rates {
+		r := decimal.RequireFromString(rate)
+		for _, mode := range modes {
+			for amount := int64(-500); amount <= 500; amount += 7 {
+				m := New(amount, EUR)
+
+				net, tax, err := m.ExtractTax(r, mode)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if sum, _ := net.Add(tax); sum.Amount() != amount || !tax.amount.IsInteger() {
+					t.Errorf("Expected net %s and tax %s of %d at %s%% (%s) to sum back", net.Decimal(), tax.Decimal(), amount, rate, mode)
+				}
+
+				gross, tax, err := m.AddTax(r, mode)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff, _ := gross.Subtract(tax); diff.Amount() != amount || !tax.amount.IsInteger() {
+					t.Errorf("Expected gross %s minus tax %s of %d at %s%% (%s) to be the net amount", gross.Decimal(), tax.Decimal(), amount, rate, mode)
+				}
+			}
+		}
+	}
+}
+
+func TestMoney_TaxInvalidRate(t *testing.T) {
+	if _, _, err := New(100, EUR).AddTax(decimal.NewFromInt(-1)); !errors.Is(err, ErrInvalidTaxRate) {
+		t.Errorf("Expected %v got %v", ErrInvalidTaxRate, err)
+	}
+
+	if _, _, err := New(100, EUR).ExtractTax(decimal.NewFromInt(-100)); !errors.Is(err, ErrInvalidTaxRate) {
+		t.Errorf("Expected %v got %v", ErrInvalidTaxRate, err)
+	}
+}
@@ -0,0 +1,136 @@
+package money
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// JSONCodec encodes Money to and from JSON, with the same signatures as the MarshalJSON and UnmarshalJSON hooks.
+type JSONCodec struct {
+	Marshal   func(m Money) ([]byte, error)
+	Unmarshal func(m *Money, b []byte) error
+}
+
+// Tenant bundles the currency behaviour of one tenant of a multi-tenant service: the registry of its currencies,
+// the locale Money is displayed and parsed in, the rounding mode of its calculations and its JSON encoding.
+// Each Tenant is independent, so tenants can behave differently without changing the package-level settings.
+// The zero value behaves like the package-level functions.
+type Tenant struct {
+	// Name identifies the tenant, e.g. in logs.
+	Name string
+
+	// Registry holds the currencies of the tenant; the DefaultRegistry is used when nil.
+	Registry *Registry
+
+	// Locale is the name of the locale Money is displayed in, see DisplayIn. Money is displayed in
+	// the conventions of its currency when it is empty or not registered.
+	Locale string
+
+	// Rounding is the rounding mode of the tenant calculations, half away from zero by default.
+	Rounding RoundingMode
+
+	// JSON encodes Money for the tenant; nil functions fall back to the MarshalJSON and UnmarshalJSON hooks.
+	JSON JSONCodec
+}
+
+// DefaultTenant is the Tenant returned by TenantFromContext for contexts without a tenant.
+var DefaultTenant = &Tenant{}
+
+// tenantKey is the context key of the Tenant.
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx carrying the tenant, e.g. set by a middleware once the tenant of a request is known.
+func WithTenant(ctx context.Context, t *Tenant) context.Context {
+	return context.WithValue(ctx, tenantKey{}, t)
+}
+
+// TenantFromContext returns the Tenant carried by ctx, or the DefaultTenant when it carries none.
+func TenantFromContext(ctx context.Context) *Tenant {
+	if t, ok := ctx.Value(tenantKey{}).(*Tenant); ok && t != nil {
+		return t
+	}
+
+	return DefaultTenant
+}
+
+// New creates and returns new instance of Money in a currency of the tenant registry.
+func (t *Tenant) New(amount int64, code string) *Money {
+	return t.registry().New(amount, code)
+}
+
+// NewFromString creates and returns new instance of Money in a currency of the tenant registry from a string
+// holding an exact amount of major units, see NewFromString. Amounts more precise than the currency allows are
+// rejected with a *PrecisionError.
+func (t *Tenant) NewFromString(s, code string) (*Money, error) {
+	return t.registry().NewFromString(s, code)
+}
+
+// Parser returns a Parser recognising the currencies of the tenant registry and resolving shared symbols
+// by the tenant locale.
+func (t *Tenant) Parser() *Parser {
+	return &Parser{Registry: t.Registry, Locale: t.Locale}
+}
+
+// Display returns Money formatted in the tenant locale, or with the conventions of its currency when the tenant
+// has no registered locale.
+func (t *Tenant) Display(m *Money) string {
+	if l := GetLocale(t.Locale); l != nil {
+		return l.Format(m)
+	}
+
+	return m.Display()
+}
+
+// Round returns Money rounded to whole minor units of its currency with the tenant rounding mode.
+func (t *Tenant) Round(m *Money) *Money {
+	return m.RoundToCurrency(t.Rounding)
+}
+
+// Divide returns Money divided by the divisors, rounded once to whole minor units with the tenant rounding mode.
+func (t *Tenant) Divide(m *Money, divisors ...int64) (*Money, error) {
+	return m.DivideWithMode(t.Rounding, divisors...)
+}
+
+// Percent returns p percent of Money, rounded to whole minor units with the tenant rounding mode.
+func (t *Tenant) Percent(m *Money, p decimal.Decimal) *Money {
+	return m.PercentDecimal(p, t.Rounding)
+}
+
+// EncodeJSON returns the JSON encoding of Money using the tenant codec.
+func (t *Tenant) EncodeJSON(m *Money) ([]byte, error) {
+	if t.JSON.Marshal != nil {
+		return t.JSON.Marshal(*m)
+	}
+
+	return m.MarshalJSON()
+}
+
+// DecodeJSON decodes JSON into Money using the tenant codec. The currency is then looked up in the
+// tenant registry, so tenant-specific currencies decode with their own fraction and symbol.
+func (t *Tenant) DecodeJSON(b []byte, m *Money) error {
+	var err error
+	if t.JSON.Unmarshal != nil {
+		err = t.JSON.Unmarshal(m, b)
+	} else {
+		err = m.UnmarshalJSON(b)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if m.currency != nil {
+		m.currency = t.registry().get(m.currency.Code)
+	}
+
+	return nil
+}
+
+func (t *Tenant) registry() *Registry {
+	if t.Registry == nil {
+		return DefaultRegistry
+	}
+
+	return t.Registry
+}
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTenantFromContext(t *testing.T) {
+	if got := TenantFromContext(context.Background()); got != DefaultTenant {
+		t.Errorf("Expected the DefaultTenant got %v", got)
+	}
+
+	acme := &Tenant{Name: "acme"}
+	ctx := WithTenant(context.Background(), acme)
+	if got := TenantFromContext(ctx); got != acme {
+		t.Errorf("Expected %v got %v", acme, got)
+	}
+
+	if got := TenantFromContext(WithTenant(ctx, nil)); got != DefaultTenant {
+		t.Errorf("Expected the DefaultTenant for a nil tenant got %v", got)
+	}
+}
+
+func TestTenant_Registry(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+	acme := &Tenant{Registry: r}
+
+	m := acme.New(1500, "PTS")
+	if d := m.Display(); d != "1,500 pts" {
+		t.Errorf("Expected %s got %s", "1,500 pts", d)
+	}
+
+	if _, err := acme.NewFromString("1.5", "PTS"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount got %v", err)
+	}
+
+	p, err := acme.Parser().Parse("1,500 pts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Currency() != m.Currency() {
+		t.Errorf("Expected the tenant currency got %+v", p.Currency())
+	}
+
+	if DefaultTenant.New(1500, "PTS").Currency().Fraction != 2 {
+		t.Error("Expected the tenant currency not to leak into the DefaultRegistry")
+	}
+}
+
+func TestTenant_Locale(t *testing.T) {
+	m := New(123456, EUR)
+
+	if d := (&Tenant{Locale: "de-DE"}).Display(m); d != "1.234,56 €" {
+		t.Errorf("Expected %s got %s", "1.234,56 €", d)
+	}
+
+	if d := (&Tenant{Locale: "xx-XX"}).Display(m); d != m.Display() {
+		t.Errorf("Expected %s got %s", m.Display(), d)
+	}
+
+	p, err := (&Tenant{Locale: "en-CA"}).Parser().Parse("$1.00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Currency().Code != CAD {
+		t.Errorf("Expected %s got %s", CAD, p.Currency().Code)
+	}
+}
+
+func TestTenant_Rounding(t *testing.T) {
+	even := &Tenant{Rounding: RoundHalfEven}
+	up := &Tenant{}
+
+	m := New(250, EUR)
+	if r, _ := even.Divide(m, 100); r.Amount() != 2 {
+		t.Errorf("Expected %d got %d", 2, r.Amount())
+	}
+	if r, _ := up.Divide(m, 100); r.Amount() != 3 {
+		t.Errorf("Expected %d got %d", 3, r.Amount())
+	}
+
+	if r := even.Percent(New(50, EUR), decimal.NewFromInt(5)); r.Amount() != 2 {
+		t.Errorf("Expected %d got %d", 2, r.Amount())
+	}
+
+	f := NewFromDecimal(decimal.RequireFromString("0.125"), EUR)
+	if r := even.Round(f); r.Amount() != 12 {
+		t.Errorf("Expected %d got %d", 12, r.Amount())
+	}
+}
+
+func TestTenant_JSON(t *testing.T) {
+	MarshalJSON, UnmarshalJSON = defaultMarshalJSON, defaultUnmarshalJSON
+
+	r := NewRegistry()
+	if _, err := r.AddCurrency("PTS", "pts", "1 $", ".", ",", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	acme := &Tenant{Registry: r}
+	var m Money
+	if err := acme.DecodeJSON([]byte(`{"amount": 1500, "currency": "PTS"}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Currency() != r.GetCurrency("PTS") {
+		t.Errorf("Expected the tenant currency got %+v", m.Currency())
+	}
+
+	b, err := acme.EncodeJSON(&m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"amount": 1500, "currency": "PTS"}` {
+		t.Errorf("Expected default encoding got %s", b)
+	}
+
+	codec := &Tenant{JSON: JSONCodec{
+		Marshal: func(m Money) ([]byte, error) {
+			return []byte(`"` + m.MachineString() + `"`), nil
+		},
+		Unmarshal: func(m *Money, b []byte) error {
+			*m = *New(1, USD)
+			return nil
+		},
+	}}
+
+	if b, _ := codec.EncodeJSON(New(1500, EUR)); string(b) != `"15.00"` {
+		t.Errorf("Expected %s got %s", `"15.00"`, b)
+	}
+
+	if err := codec.DecodeJSON([]byte(`"15.00"`), &m); err != nil || m.Currency().Code != USD {
+		t.Errorf("Expected the tenant codec to decode USD got %v, %v", m.Currency(), err)
+	}
+}
@@ -0,0 +1,101 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInvalidTick happens when an order price isn't a whole multiple of its tick size.
+	ErrInvalidTick = newError("money.invalid_tick", "price is not a multiple of the tick size")
+
+	// ErrInvalidLot happens when an order quantity isn't a whole multiple of its lot size.
+	ErrInvalidLot = newError("money.invalid_lot", "quantity is not a multiple of the lot size")
+)
+
+// TradingRules holds the order entry constraints of a currency or an instrument.
+type TradingRules struct {
+	// Tick is the increment prices must be a multiple of, in major units, e.g. 0.05 for €0.05.
+	// Zero accepts any price in whole minor units.
+	Tick decimal.Decimal
+	// Lot is the increment quantities must be a multiple of, e.g. 100 for round lots of shares.
+	// Zero accepts any quantity.
+	Lot decimal.Decimal
+	// Validate is an optional hook for further checks, such as price bands, run after the tick and lot checks.
+	Validate func(price *Money, quantity decimal.Decimal) error
+}
+
+// SetTradingRules lets you insert or update the trading rules of a currency code or an instrument in the registry.
+// It returns ErrRegistryFrozen once the registry is frozen.
+func (r *Registry) SetTradingRules(key string, rules TradingRules) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.frozen {
+		return fmt.Errorf("%w: can't set trading rules of %s", ErrRegistryFrozen, key)
+	}
+
+	if r.trading == nil {
+		r.trading = map[string]TradingRules{}
+	}
+	r.trading[key] = rules
+	return nil
+}
+
+// TradingRules returns the trading rules of a currency code or an instrument, and whether any were set.
+func (r *Registry) TradingRules(key string) (TradingRules, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rules, ok := r.trading[key]
+	return rules, ok
+}
+
+// ValidateOrder checks an order price and quantity against the trading rules of the instrument, or those of the
+// price currency when the instrument has none or is empty. It returns an error wrapping ErrInvalidTick or
+// ErrInvalidLot, or the error of the Validate hook. Orders without applicable rules are valid.
+func (r *Registry) ValidateOrder(instrument string, price *Money, quantity decimal.Decimal) error {
+	rules, ok := r.TradingRules(instrument)
+	if !ok || instrument == "" {
+		if rules, ok = r.TradingRules(price.currency.Code); !ok {
+			return nil
+		}
+	}
+
+	if !rules.Tick.IsZero() {
+		tick := &Money{amount: rules.Tick.Shift(int32(price.currency.Fraction)), currency: price.currency}
+		ok, err := price.IsMultipleOf(tick)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return fmt.Errorf("%w: %s is not a multiple of %s", ErrInvalidTick, price.Decimal(), rules.Tick)
+		}
+	}
+
+	if !rules.Lot.IsZero() {
+		if _, rest := quantity.QuoRem(rules.Lot, 0); !rest.IsZero() {
+			return fmt.Errorf("%w: %s is not a multiple of %s", ErrInvalidLot, quantity, rules.Lot)
+		}
+	}
+
+	if rules.Validate != nil {
+		return rules.Validate(price, quantity)
+	}
+
+	return nil
+}
+
+// SetTradingRules lets you insert or update the trading rules of a currency code or an instrument
+// in the DefaultRegistry. It returns ErrRegistryFrozen once the DefaultRegistry is frozen.
+func SetTradingRules(key string, rules TradingRules) error {
+	return DefaultRegistry.SetTradingRules(key, rules)
+}
+
+// ValidateOrder checks an order price and quantity against the trading rules of the DefaultRegistry,
+// see Registry.ValidateOrder.
+func ValidateOrder(instrument string, price *Money, quantity decimal.Decimal) error {
+	return DefaultRegistry.ValidateOrder(instrument, price, quantity)
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRegistry_ValidateOrder(t *testing.T) {
+	r := NewRegistry()
+	d := decimal.RequireFromString
+
+	r.SetTradingRules(EUR, TradingRules{Tick: d("0.05")})
+	r.SetTradingRules("ACME", TradingRules{Tick: d("0.5"), Lot: d("100")})
+	r.SetTradingRules("BAND", TradingRules{Validate: func(price *Money, _ decimal.Decimal) error {
+		if price.Amount() > 10000 {
+			return errors.New("price outside band")
+		}
+		return nil
+	}})
+
+	tcs := []struct {
+		instrument string
+		price      *Money
+		quantity   string
+		expected   error
+	}{
+		{"", r.New(1005, EUR), "3", nil},
+		{"", r.New(1003, EUR), "3", ErrInvalidTick},
+		{"UNKNOWN", r.New(1003, EUR), "3", ErrInvalidTick},
+		{"ACME", r.New(1050, EUR), "300", nil},
+		{"ACME", r.New(1005, EUR), "300", ErrInvalidTick},
+		{"ACME", r.New(1050, EUR), "250", ErrInvalidLot},
+		{"", r.New(1003, USD), "3", nil},
+		{"BAND", r.New(1003, EUR), "1", nil},
+	}
+
+	for _, tc := range tcs {
+		if err := r.ValidateOrder(tc.instrument, tc.price, d(tc.quantity)); !errors.Is(err, tc.expected) {
+			t.Errorf("Expected %v for %s at %s x %s got %v", tc.expected, tc.instrument, tc.price.Display(), tc.quantity, err)
+		}
+	}
+
+	if err := r.ValidateOrder("BAND", r.New(20000, EUR), d("1")); err == nil || err.Error() != "price outside band" {
+		t.Errorf("Expected the Validate hook error got %v", err)
+	}
+
+	if _, ok := r.TradingRules(GBP); ok {
+		t.Error("Expected no trading rules for GBP")
+	}
+
+	if rules, ok := r.TradingRules("ACME"); !ok || !rules.Lot.Equal(d("100")) {
+		t.Errorf("Expected ACME trading rules got %+v", rules)
+	}
+
+	if _, ok := NewRegistry().TradingRules(EUR); ok {
+		t.Error("Expected trading rules to be registry-specific")
+	}
+}
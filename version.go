@@ -0,0 +1,88 @@
+package money
+
+// FormatVersion selects the version of the built-in currency display tables Money is formatted with.
+// Systems that snapshot rendered strings, such as PDF invoices and receipts, pin a version so that later fixes
+// to currency symbols or separators don't silently change their output.
+type FormatVersion int
+
+const (
+	// FormatLatest formats with the latest currency tables, including any future fix.
+	FormatLatest FormatVersion = iota
+	// FormatV1 formats like the first versioned currency tables.
+	FormatV1
+)
+
+// currentFormatVersion is the version of the built-in currency tables in currencies.
+const currentFormatVersion = FormatV1
+
+// formatChange records the display fields a built-in currency had before a fix, so that older format versions
+// keep rendering them.
+type formatChange struct {
+	// version is the first format version with the fix.
+	version  FormatVersion
+	code     string
+	grapheme string
+	template string
+	decimal  string
+	thousand string
+}
+
+// formatChanges lists the fixes made to the display fields of the built-in currencies, oldest first.
+// To fix a currency, add a FormatVersion constant, bump currentFormatVersion and record the fields
+// the currency had before here; the fraction of a currency is not a display field and can't be versioned.
+var formatChanges []formatChange
+
+// formatTables holds the built-in currencies as displayed by each format version.
+var formatTables = buildFormatTables(formatChanges)
+
+// buildFormatTables returns the built-in currencies of every format version, undoing the changes made after it.
+func buildFormatTables(changes []formatChange) map[FormatVersion]Currencies {
+	tables := map[FormatVersion]Currencies{}
+	for v := FormatV1; v <= currentFormatVersion; v++ {
+		table := builtinCurrencies.clone()
+		for i := len(changes) - 1; i >= 0; i-- {
+			ch := changes[i]
+			if c := table[ch.code]; c != nil && ch.version > v {
+				c.Grapheme, c.Template, c.Decimal, c.Thousand = ch.grapheme, ch.template, ch.decimal, ch.thousand
+			}
+		}
+		tables[v] = table
+	}
+
+	return tables
+}
+
+// formatTable returns the built-in currencies as displayed by the given format version,
+// or nil for FormatLatest and versions this package doesn't know yet.
+func formatTable(v FormatVersion) Currencies {
+	return formatTables[v]
+}
+
+// NewRegistryWithFormat creates a new Registry like NewRegistry, whose built-in currencies display like the given
+// format version. Money created from it keeps rendering the same strings across upgrades of this package.
+func NewRegistryWithFormat(v FormatVersion) *Registry {
+	table := formatTable(v)
+	if table == nil {
+		return NewRegistry()
+	}
+
+	return &Registry{currencies: table.clone()}
+}
+
+// DisplayVersion lets represent Money struct as string like Display, using the display fields the currency had
+// in the given format version. Currencies that aren't built in, or whose display was customized with
+// AddCurrency, display as they are; FormatLatest and unknown versions display like Display.
+func (m *Money) DisplayVersion(v FormatVersion) string {
+	c := m.currency
+	if table := formatTable(v); table != nil && sameDisplay(c, builtinCurrencies[c.Code]) {
+		c = table[c.Code]
+	}
+
+	return c.Formatter().Format(m.amount.IntPart())
+}
+
+// sameDisplay reports whether c displays like the built-in currency b.
+func sameDisplay(c, b *Currency) bool {
+	return b != nil && c.Fraction == b.Fraction && c.Grapheme == b.Grapheme && c.Template == b.Template &&
+		c.Decimal == b.Decimal && c.Thousand == b.Thousand
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFormatV1_Golden(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "currencies.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := NewRegistryWithFormat(FormatV1).VerifyGolden(f); err != nil {
+		t.Errorf("Expected format version 1 to match its golden file: %v", err)
+	}
+}
+
+func TestMoney_DisplayVersion(t *testing.T) {
+	for _, c := range NewRegistry().Currencies() {
+		m := New(-123456, c.Code)
+		if got := m.DisplayVersion(FormatV1); got != m.Display() {
+			t.Errorf("Expected %s to display %q in version 1 got %q", c.Code, m.Display(), got)
+		}
+	}
+}
+
+func TestFormatVersion_PinsFixes(t *testing.T) {
+	// pretend a later version fixed the euro symbol, which was "EUR" in version 1
+	defer func(tables map[FormatVersion]Currencies) { formatTables = tables }(formatTables)
+	formatTables = buildFormatTables([]formatChange{
+		{version: FormatV1 + 1, code: EUR, grapheme: "EUR", template: "1 $", decimal: ",", thousand: "."},
+	})
+
+	m := New(123456, EUR)
+	if got := m.DisplayVersion(FormatV1); got != "1.234,56 EUR" {
+		t.Errorf("Expected %q got %q", "1.234,56 EUR", got)
+	}
+
+	if got := m.DisplayVersion(FormatLatest); got != m.Display() {
+		t.Errorf("Expected %q got %q", m.Display(), got)
+	}
+
+	if got := NewRegistryWithFormat(FormatV1).New(123456, EUR).Display(); got != "1.234,56 EUR" {
+		t.Errorf("Expected %q got %q", "1.234,56 EUR", got)
+	}
+
+	if got := NewRegistryWithFormat(FormatV1+5).New(123456, EUR).Display(); got != m.Display() {
+		t.Errorf("Expected unknown versions to display like the latest got %q", got)
+	}
+
+	r := NewRegistry()
+	if _, err := r.AddCurrency(EUR, "euro", "1 $", ".", ",", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := r.New(123456, EUR).DisplayVersion(FormatV1); got != "1,234.56 euro" {
+		t.Errorf("Expected a customized currency to display as it is got %q", got)
+	}
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"encoding/xml"
+)
+
+// Injection points for the XML encoding of Money, mirroring UnmarshalJSON and MarshalJSON.
+// Overwrite them to adapt the wire shape, e.g. to write the currency as an attribute:
+//
+//	money.UnmarshalXML = func (m *Money, d *xml.Decoder, start xml.StartElement) error { ... }
+//	money.MarshalXML = func (m Money, e *xml.Encoder, start xml.StartElement) error { ... }
+var (
+	// UnmarshalXML is injection point of xml.Unmarshaler for money.Money
+	UnmarshalXML = defaultUnmarshalXML
+	// MarshalXML is injection point of xml.Marshaler for money.Money
+	MarshalXML = defaultMarshalXML
+)
+
+// xmlMoney is the default XML shape of Money: <price><amount>12345</amount><currency>EUR</currency></price>.
+type xmlMoney struct {
+	Amount   int64  `xml:"amount"`
+	Currency string `xml:"currency"`
+}
+
+func defaultUnmarshalXML(m *Money, d *xml.Decoder, start xml.StartElement) error {
+	var v xmlMoney
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	if v.Amount == 0 && v.Currency == "" {
+		*m = Money{}
+		return nil
+	}
+
+	*m = *New(v.Amount, v.Currency)
+	return nil
+}
+
+// defaultMarshalXML writes the amount in minor units, returning ErrLossyConversion for Money carrying precision
+// beyond the currency fraction rather than dropping it.
+func defaultMarshalXML(m Money, e *xml.Encoder, start xml.StartElement) error {
+	if m == (Money{}) {
+		m = *New(0, "")
+	}
+
+	if !m.amount.IsInteger() {
+		return ErrLossyConversion
+	}
+
+	return e.EncodeElement(xmlMoney{Amount: m.Amount(), Currency: m.Currency().Code}, start)
+}
+
+// UnmarshalXML is implementation of xml.Unmarshaler
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return UnmarshalXML(m, d, start)
+}
+
+// MarshalXML is implementation of xml.Marshaler
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(m, e, start)
+}
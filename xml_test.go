@@ -0,0 +1,92 @@
+package money
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_XML(t *testing.T) {
+	type order struct {
+		XMLName xml.Name `xml:"order"`
+		Total   Money    `xml:"total"`
+	}
+
+	b, err := xml.Marshal(order{Total: *New(12345, EUR)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<order><total><amount>12345</amount><currency>EUR</currency></total></order>`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, b)
+	}
+
+	var o order
+	if err := xml.Unmarshal(b, &o); err != nil {
+		t.Fatal(err)
+	}
+
+	if o.Total.Display() != "€123.45" {
+		t.Errorf("Expected %s got %s", "€123.45", o.Total.Display())
+	}
+}
+
+func TestMoney_XMLZeroValue(t *testing.T) {
+	b, err := xml.Marshal(Money{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := *New(100, EUR)
+	if err := xml.Unmarshal(b, &m); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero Money from %s got %v %v", b, m, err)
+	}
+}
+
+func TestMoney_XMLSubMinorUnits(t *testing.T) {
+	m := Money{amount: decimal.RequireFromString("319.9"), currency: newCurrency(EUR).get()}
+	if _, err := xml.Marshal(m); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v", ErrLossyConversion, err)
+	}
+}
+
+func TestCustomXML(t *testing.T) {
+	defer func() {
+		MarshalXML, UnmarshalXML = defaultMarshalXML, defaultUnmarshalXML
+	}()
+
+	type attrMoney struct {
+		Amount   int64  `xml:",chardata"`
+		Currency string `xml:"currency,attr"`
+	}
+
+	MarshalXML = func(m Money, e *xml.Encoder, start xml.StartElement) error {
+		return e.EncodeElement(attrMoney{Amount: m.Amount(), Currency: m.Currency().Code}, start)
+	}
+	UnmarshalXML = func(m *Money, d *xml.Decoder, start xml.StartElement) error {
+		var v attrMoney
+		if err := d.DecodeElement(&v, &start); err != nil {
+			return err
+		}
+		*m = *New(v.Amount, v.Currency)
+		return nil
+	}
+
+	b, err := xml.Marshal(New(500, USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<Money currency="USD">500</Money>`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, b)
+	}
+
+	var m Money
+	if err := xml.Unmarshal(b, &m); err != nil || m.Display() != "$5.00" {
+		t.Errorf("Expected %s got %v %v", "$5.00", m, err)
+	}
+}
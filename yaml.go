@@ -0,0 +1,59 @@
+package money
+
+// Injection points for the YAML encoding of Money, mirroring UnmarshalJSON and MarshalJSON.
+// Money implements the marshaler interfaces shared by gopkg.in/yaml.v2 and gopkg.in/yaml.v3,
+// so no YAML library is imported here. Overwrite them to adapt the document shape:
+//
+//	money.UnmarshalYAML = func (m *Money, unmarshal func(interface{}) error) error { ... }
+//	money.MarshalYAML = func (m Money) (interface{}, error) { ... }
+var (
+	// UnmarshalYAML is injection point of yaml.Unmarshaler for money.Money
+	UnmarshalYAML = defaultUnmarshalYAML
+	// MarshalYAML is injection point of yaml.Marshaler for money.Money
+	MarshalYAML = defaultMarshalYAML
+)
+
+// yamlMoney is the default YAML shape of Money, a mapping with the amount in minor units and the currency code.
+type yamlMoney struct {
+	Amount   int64  `yaml:"amount"`
+	Currency string `yaml:"currency"`
+}
+
+func defaultUnmarshalYAML(m *Money, unmarshal func(interface{}) error) error {
+	var v yamlMoney
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	if v.Amount == 0 && v.Currency == "" {
+		*m = Money{}
+		return nil
+	}
+
+	*m = *New(v.Amount, v.Currency)
+	return nil
+}
+
+// defaultMarshalYAML writes the amount in minor units, returning ErrLossyConversion for Money carrying precision
+// beyond the currency fraction rather than dropping it.
+func defaultMarshalYAML(m Money) (interface{}, error) {
+	if m == (Money{}) {
+		m = *New(0, "")
+	}
+
+	if !m.amount.IsInteger() {
+		return nil, ErrLossyConversion
+	}
+
+	return yamlMoney{Amount: m.Amount(), Currency: m.Currency().Code}, nil
+}
+
+// UnmarshalYAML is implementation of yaml.Unmarshaler
+func (m *Money) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return UnmarshalYAML(m, unmarshal)
+}
+
+// MarshalYAML is implementation of yaml.Marshaler
+func (m Money) MarshalYAML() (interface{}, error) {
+	return MarshalYAML(m)
+}
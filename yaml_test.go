@@ -0,0 +1,88 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_MarshalYAML(t *testing.T) {
+	v, err := New(12345, EUR).MarshalYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != (yamlMoney{Amount: 12345, Currency: EUR}) {
+		t.Errorf("Expected %v got %v", yamlMoney{Amount: 12345, Currency: EUR}, v)
+	}
+
+	if v, _ := (Money{}).MarshalYAML(); v != (yamlMoney{}) {
+		t.Errorf("Expected %v got %v", yamlMoney{}, v)
+	}
+}
+
+func TestMoney_MarshalYAMLSubMinorUnits(t *testing.T) {
+	m := Money{amount: decimal.RequireFromString("319.9"), currency: newCurrency(EUR).get()}
+	if v, err := m.MarshalYAML(); !errors.Is(err, ErrLossyConversion) {
+		t.Errorf("Expected %v got %v %v", ErrLossyConversion, v, err)
+	}
+}
+
+func TestMoney_UnmarshalYAML(t *testing.T) {
+	decode := func(doc yamlMoney) func(interface{}) error {
+		return func(v interface{}) error {
+			*v.(*yamlMoney) = doc
+			return nil
+		}
+	}
+
+	var m Money
+	if err := m.UnmarshalYAML(decode(yamlMoney{Amount: 10012, Currency: USD})); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "$100.12" {
+		t.Errorf("Expected %s got %s", "$100.12", m.Display())
+	}
+
+	if err := m.UnmarshalYAML(decode(yamlMoney{})); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero Money got %v %v", m, err)
+	}
+
+	failure := errors.New("bad document")
+	if err := m.UnmarshalYAML(func(interface{}) error { return failure }); !errors.Is(err, failure) {
+		t.Errorf("Expected %v got %v", failure, err)
+	}
+}
+
+func TestCustomYAML(t *testing.T) {
+	defer func() {
+		MarshalYAML, UnmarshalYAML = defaultMarshalYAML, defaultUnmarshalYAML
+	}()
+
+	MarshalYAML = func(m Money) (interface{}, error) {
+		return m.MachineString() + " " + m.Currency().Code, nil
+	}
+	UnmarshalYAML = func(m *Money, unmarshal func(interface{}) error) error {
+		var s string
+		if err := unmarshal(&s); err != nil {
+			return err
+		}
+		return m.UnmarshalText([]byte(s))
+	}
+
+	v, err := New(500, USD).MarshalYAML()
+	if err != nil || v != "5.00 USD" {
+		t.Errorf("Expected %s got %v %v", "5.00 USD", v, err)
+	}
+
+	var m Money
+	err = m.UnmarshalYAML(func(v interface{}) error {
+		*v.(*string) = "5.00 USD"
+		return nil
+	})
+	if err != nil || m.Display() != "$5.00" {
+		t.Errorf("Expected %s got %v %v", "$5.00", m, err)
+	}
+}